@@ -0,0 +1,138 @@
+package manifold
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ActivitySummary aggregates the actions taken by a user over a period.
+type ActivitySummary struct {
+	UserID         string    // ID of the user the summary is for
+	Start          time.Time // Start of the summarized period
+	End            time.Time // End of the summarized period
+	BetsPlaced     int       // Number of bets placed, including sales and limit orders
+	AmountBet      float64   // Total amount spent on bets
+	AmountSold     float64   // Total amount received from sales
+	FeesPaid       float64   // Total fees paid on bets
+	MarketsCreated int       // Number of markets created
+	CommentsPosted int       // Number of comments posted
+	ManagramsSent  int       // Number of managrams sent
+	ManaSent       float64   // Total amount sent in managrams
+}
+
+// String renders the summary as a short multi-line digest, suitable for logs or notifications.
+func (a ActivitySummary) String() string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "Activity from %s to %s\n", a.Start.Format(time.RFC3339), a.End.Format(time.RFC3339))
+	fmt.Fprintf(&b, "Bets placed: %d (%s bet, %s sold, %s fees)\n", a.BetsPlaced, FormatMana(a.AmountBet, 2), FormatMana(a.AmountSold, 2), FormatMana(a.FeesPaid, 2))
+	fmt.Fprintf(&b, "Markets created: %d\n", a.MarketsCreated)
+	fmt.Fprintf(&b, "Comments posted: %d\n", a.CommentsPosted)
+	fmt.Fprintf(&b, "Managrams sent: %d (%s)", a.ManagramsSent, FormatMana(a.ManaSent, 2))
+
+	return b.String()
+}
+
+// Activity summarizes the actions taken by the authenticated user within [start, end).
+//
+// Parameters:
+//   - start: The start of the period to summarize. Required.
+//   - end: The end of the period to summarize. Must be after start. Required.
+//
+// Returns:
+//   - *ActivitySummary: A pointer to the activity summary.
+//   - error: An error object if a request fails or if input validation fails.
+func (s *UserService) Activity(start time.Time, end time.Time) (*ActivitySummary, error) {
+	if !end.After(start) {
+		return nil, fmt.Errorf("User: Activity: end must be after start")
+	}
+
+	me, err := s.Me()
+	if err != nil {
+		return nil, fmt.Errorf("User: Activity: %w", err)
+	}
+
+	summary := &ActivitySummary{UserID: me.ID, Start: start, End: end}
+	startMs, endMs := start.UnixMilli(), end.UnixMilli()
+	inPeriod := func(t int64) bool {
+		return t >= startMs && t < endMs
+	}
+
+	bets, err := s.client.Bet.allBets(&me.ID, nil, &start)
+	if err != nil {
+		return nil, fmt.Errorf("User: Activity: %w", err)
+	}
+
+	for _, bet := range bets {
+		if !inPeriod(bet.CreatedTime) || bet.IsRedemption {
+			continue
+		}
+
+		summary.BetsPlaced++
+		if bet.Amount < 0 {
+			summary.AmountSold -= bet.Amount
+		} else {
+			summary.AmountBet += bet.Amount
+		}
+		summary.FeesPaid += bet.Fees.CreatorFee + bet.Fees.PlatformFee + bet.Fees.LiquidityFee
+	}
+
+	var (
+		limit  = 1000
+		sort   = "created-time"
+		before *string
+	)
+	for {
+		markets, err := s.client.Market.Markets(&limit, &sort, nil, before, &me.ID, nil)
+		if err != nil {
+			return nil, fmt.Errorf("User: Activity: %w", err)
+		}
+
+		for _, market := range markets {
+			if market.CreatorID == me.ID && inPeriod(market.CreatedTime) {
+				summary.MarketsCreated++
+			}
+		}
+
+		if len(markets) < limit || markets[len(markets)-1].CreatedTime < startMs {
+			break
+		}
+		before = &markets[len(markets)-1].ID
+	}
+
+	for offset := 0; ; offset += limit {
+		comments, err := s.client.Comment.Comments(nil, nil, &limit, &offset, &me.ID)
+		if err != nil {
+			return nil, fmt.Errorf("User: Activity: %w", err)
+		}
+
+		oldest := endMs
+		for _, comment := range comments {
+			if inPeriod(comment.CreatedTime) {
+				summary.CommentsPosted++
+			}
+			if comment.CreatedTime < oldest {
+				oldest = comment.CreatedTime
+			}
+		}
+
+		if len(comments) < limit || oldest < startMs {
+			break
+		}
+	}
+
+	managrams, err := s.client.Mana.Managrams(nil, &me.ID, &limit, &end, &start)
+	if err != nil {
+		return nil, fmt.Errorf("User: Activity: %w", err)
+	}
+
+	for _, managram := range managrams {
+		if inPeriod(managram.CreatedTime) {
+			summary.ManagramsSent++
+			summary.ManaSent += managram.Amount
+		}
+	}
+
+	return summary, nil
+}
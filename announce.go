@@ -0,0 +1,170 @@
+package manifold
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// Announcement describes a binary market to create, along with how to tag and announce it.
+type Announcement struct {
+	Question       string     // Question the market is based on
+	InitialProb    int        // Initial probability (between 1 and 99)
+	Description    *string    // Description of the market (optional)
+	CloseTime      *time.Time // Time when the market will close (optional)
+	Visibility     *string    // Visibility of the market ("public" or "unlisted") (optional)
+	ExtraLiquidity *int       // Extra liquidity to add to the market (optional)
+	GroupIDs       []string   // IDs of the groups to tag the market with (optional)
+	Comment        *string    // Markdown comment to post on the market once created, which is not pinned (optional)
+	WebhookURL     *string    // URL to POST a JSON notification to once the market is announced (optional)
+}
+
+// AnnouncementNotification is the JSON body posted to the webhook of an Announcement.
+type AnnouncementNotification struct {
+	ID       string `json:"id"`       // ID of the created market
+	Question string `json:"question"` // Question of the created market
+	URL      string `json:"url"`      // URL of the created market
+}
+
+// CreateAndAnnounce creates a binary market, tags it with groups, posts a first comment, and notifies a webhook.
+// Progress is recorded in state, so a failed call can be retried with the same state without creating a duplicate market.
+// The comment is posted first but not pinned, as the public API has no endpoint for pinning comments, just as it has
+// none for editing them.
+//
+// Parameters:
+//   - announcement: The market to create and how to announce it. Required.
+//   - state: The progress of a previous attempt. A zero value starts from the beginning. Required.
+//   - dryRun: If true, validates the announcement and returns the planned steps without performing them.
+//
+// Returns:
+//   - *LiteMarket: A pointer to the created market, or nil on a dry run.
+//   - []string: A description of every step performed, or planned on a dry run.
+//   - error: An error object if a step fails or if input validation fails.
+func (s *MarketService) CreateAndAnnounce(announcement Announcement, state *WorkflowState, dryRun bool) (*LiteMarket, []string, error) {
+	if err := checkInRange(announcement.InitialProb, 1, 99); err != nil {
+		return nil, nil, fmt.Errorf("Market: CreateAndAnnounce(initialProb): %w", err)
+	}
+
+	var (
+		market *LiteMarket
+		steps  []string
+	)
+
+	workflow := &Workflow{}
+	add := func(name string, description string, run func(state *WorkflowState) error) {
+		steps = append(steps, description)
+		workflow.Steps = append(workflow.Steps, WorkflowStep{Name: name, Run: run})
+	}
+
+	add("create", fmt.Sprintf("create binary market %q at %d%%", announcement.Question, announcement.InitialProb), func(state *WorkflowState) error {
+		created, err := s.CreateBinary(announcement.Question, announcement.InitialProb, announcement.Description, announcement.CloseTime, announcement.Visibility, announcement.ExtraLiquidity)
+		if err != nil {
+			return err
+		}
+
+		market = created
+		state.Set("marketId", created.ID)
+
+		return nil
+	})
+
+	// load fetches the market created by an earlier attempt when resuming.
+	load := func(state *WorkflowState) (*LiteMarket, error) {
+		if market != nil {
+			return market, nil
+		}
+
+		id, ok := state.Get("marketId")
+		if !ok {
+			return nil, fmt.Errorf("missing created market ID")
+		}
+
+		full, err := s.Market(id)
+		if err != nil {
+			return nil, err
+		}
+
+		market = &full.LiteMarket
+
+		return market, nil
+	}
+
+	for _, groupID := range announcement.GroupIDs {
+		groupID := groupID
+		add("group:"+groupID, fmt.Sprintf("tag market with group %s", groupID), func(state *WorkflowState) error {
+			m, err := load(state)
+			if err != nil {
+				return err
+			}
+
+			return s.Group(m.ID, groupID, nil)
+		})
+	}
+
+	if announcement.Comment != nil {
+		add("comment", "post announcement comment", func(state *WorkflowState) error {
+			m, err := load(state)
+			if err != nil {
+				return err
+			}
+
+			return s.client.Comment.CommentMarkdown(m.ID, *announcement.Comment)
+		})
+	}
+
+	if announcement.WebhookURL != nil {
+		add("notify", fmt.Sprintf("notify webhook %s", *announcement.WebhookURL), func(state *WorkflowState) error {
+			m, err := load(state)
+			if err != nil {
+				return err
+			}
+
+			return s.client.notifyWebhook(*announcement.WebhookURL, AnnouncementNotification{ID: m.ID, Question: m.Question, URL: m.URL})
+		})
+	}
+
+	if dryRun {
+		return nil, steps, nil
+	}
+
+	if err := workflow.Run(state); err != nil {
+		return market, steps, fmt.Errorf("Market: CreateAndAnnounce: %w", err)
+	}
+
+	market, err := load(state)
+	if err != nil {
+		return nil, steps, fmt.Errorf("Market: CreateAndAnnounce: %w", err)
+	}
+
+	return market, steps, nil
+}
+
+// notifyWebhook posts a JSON body to an external webhook using the client's HTTP client, User-Agent and custom
+// headers. The API key is not sent.
+func (c *Client) notifyWebhook(url string, body interface{}) error {
+	jsonBody, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewBuffer(jsonBody))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	c.setDefaultHeaders(req)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("webhook returned status %s", resp.Status)
+	}
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package manifold
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestNotifyWebhookSendsClientHeaders(t *testing.T) {
+	var got http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Clone()
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := NewClient("key")
+	client.UserAgent = "announcer/1.0"
+	client.Headers = http.Header{"X-Team": []string{"markets"}}
+
+	if err := client.notifyWebhook(server.URL, AnnouncementNotification{ID: "m"}); err != nil {
+		t.Fatalf("notifyWebhook() error = %v", err)
+	}
+
+	tests := []struct {
+		header string
+		want   string
+	}{
+		{"User-Agent", "announcer/1.0"},
+		{"X-Team", "markets"},
+		{"Content-Type", "application/json"},
+		{"Authorization", ""},
+	}
+
+	for _, tt := range tests {
+		if value := got.Get(tt.header); value != tt.want {
+			t.Errorf("%s = %q, want %q", tt.header, value, tt.want)
+		}
+	}
+}
@@ -0,0 +1,328 @@
+package manifold
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// betFill is a single fill of a bet, as replayed by AttributePnL, Inventory and ReconstructPortfolio.
+type betFill struct {
+	amount    float64
+	shares    float64
+	fees      Fees
+	timestamp int64
+}
+
+// betFills returns the fills of a bet. Limit orders are broken down into their fills, while market orders count as a
+// single fill at the time they were placed.
+func betFills(bet Bet) []betFill {
+	if bet.LimitProps == nil {
+		return []betFill{{bet.Amount, bet.Shares, bet.Fees, bet.CreatedTime}}
+	}
+
+	fills := make([]betFill, len(bet.LimitProps.Fills))
+	for i, f := range bet.LimitProps.Fills {
+		fills[i] = betFill{f.Amount, f.Shares, f.Fees, f.Timestamp}
+	}
+
+	return fills
+}
+
+// PnLAttribution breaks down the trading profit of a user in a single market into its sources.
+type PnLAttribution struct {
+	ContractID       string  // ID of the market
+	Fills            int     // Number of fills counted
+	Volume           float64 // Total absolute amount traded
+	SpreadCapture    float64 // Profit from trading at a better price than the probability at order placement
+	AdverseSelection float64 // Profit from the probability moving between order placement and the mark
+	Fees             float64 // Fees paid on the fills
+	Total            float64 // SpreadCapture + AdverseSelection - Fees
+}
+
+// AttributePnL attributes the mark-to-market profit of a user's fills within [start, end) per market.
+// Each fill is compared against the probability when its order was placed (spread capture) and that
+// probability is compared against the mark (adverse selection). Limit orders are broken down into their
+// fills, while market orders count as a single fill. The profit is not realized profit: the shares bought
+// within the interval are valued at the mark, whether or not they were sold or resolved.
+//
+// Parameters:
+//   - bets: The bets placed by a single user. Required.
+//   - marks: The probability to mark positions at, usually as of end, keyed by answer ID for multiple choice markets and by market ID otherwise. Required.
+//   - start: Only count fills from this time onwards. Required.
+//   - end: Only count fills before this time. Required.
+//
+// Returns:
+//   - []PnLAttribution: The attribution for every market with fills in the interval, ordered by market ID.
+//   - error: An error object if the mark of a traded market is missing.
+func AttributePnL(bets []Bet, marks map[string]float64, start time.Time, end time.Time) ([]PnLAttribution, error) {
+	startMs, endMs := start.UnixMilli(), end.UnixMilli()
+	byContract := make(map[string]*PnLAttribution)
+
+	for _, bet := range bets {
+		if bet.IsRedemption || (bet.Outcome != "YES" && bet.Outcome != "NO") {
+			continue
+		}
+
+		key := bet.ContractID
+		if bet.AnswerID != nil {
+			key = *bet.AnswerID
+		}
+
+		for _, f := range betFills(bet) {
+			if f.timestamp < startMs || f.timestamp >= endMs || f.shares == 0 {
+				continue
+			}
+
+			mark, ok := marks[key]
+			if !ok {
+				return nil, fmt.Errorf("AttributePnL: missing mark for %s", key)
+			}
+
+			mid := bet.ProbBefore
+			if bet.Outcome == "NO" {
+				mid, mark = 1-mid, 1-mark
+			}
+
+			attribution, ok := byContract[bet.ContractID]
+			if !ok {
+				attribution = &PnLAttribution{ContractID: bet.ContractID}
+				byContract[bet.ContractID] = attribution
+			}
+
+			price := f.amount / f.shares
+			attribution.Fills++
+			if f.amount < 0 {
+				attribution.Volume -= f.amount
+			} else {
+				attribution.Volume += f.amount
+			}
+			attribution.SpreadCapture += f.shares * (mid - price)
+			attribution.AdverseSelection += f.shares * (mark - mid)
+			attribution.Fees += f.fees.CreatorFee + f.fees.PlatformFee + f.fees.LiquidityFee
+		}
+	}
+
+	attributions := make([]PnLAttribution, 0, len(byContract))
+	for _, attribution := range byContract {
+		attribution.Total = attribution.SpreadCapture + attribution.AdverseSelection - attribution.Fees
+		attributions = append(attributions, *attribution)
+	}
+
+	sort.Slice(attributions, func(i, j int) bool {
+		return attributions[i].ContractID < attributions[j].ContractID
+	})
+
+	return attributions, nil
+}
+
+// PnLAttribution fetches a user's bets and the probabilities of the markets they traded in as of end,
+// and attributes the profit of their fills within [start, end) per market (see AttributePnL). Markets that
+// had resolved by end are marked at their resolution.
+//
+// Parameters:
+//   - userID: The ID of the user. Required.
+//   - start: Only count fills from this time onwards. Required.
+//   - end: Only count fills before this time. Required.
+//
+// Returns:
+//   - []PnLAttribution: The attribution for every market with fills in the interval.
+//   - error: An error object if a request fails or if the response cannot be parsed.
+func (s *BetService) PnLAttribution(userID string, start time.Time, end time.Time) ([]PnLAttribution, error) {
+	bets, err := s.allBets(&userID, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("Bet: PnLAttribution: %w", err)
+	}
+
+	// Only limit orders can have fills after the bet was placed.
+	var traded []Bet
+	for _, bet := range bets {
+		if bet.CreatedTime < end.UnixMilli() && (bet.LimitProps != nil || bet.CreatedTime >= start.UnixMilli()) {
+			traded = append(traded, bet)
+		}
+	}
+
+	marks, err := s.marksAt(traded, end)
+	if err != nil {
+		return nil, fmt.Errorf("Bet: PnLAttribution: %w", err)
+	}
+
+	attributions, err := AttributePnL(bets, marks, start, end)
+	if err != nil {
+		return nil, fmt.Errorf("Bet: %w", err)
+	}
+
+	return attributions, nil
+}
+
+// MarketInventory is the inventory of a user in a single market (or answer) over a period.
+type MarketInventory struct {
+	ContractID string             // ID of the market
+	AnswerID   *string            // ID of the answer, for multiple choice markets (optional)
+	Opening    map[string]float64 // Shares held per outcome at the start of the period
+	Bought     map[string]float64 // Shares bought per outcome within the period
+	Sold       map[string]float64 // Shares sold per outcome within the period
+	Closing    map[string]float64 // Shares held per outcome at the end of the period
+	Net        float64            // Closing YES shares minus closing NO shares
+	Mark       float64            // Probability the closing shares are marked at
+	Value      float64            // Value of the closing shares at the mark
+}
+
+// Inventory replays the fills of a user's bets into the inventory they held in every market over [start, end), such
+// as the inventory a market maker accumulated. Limit orders only count from the time they were filled.
+//
+// Parameters:
+//   - bets: The bets placed by a single user. Required.
+//   - marks: The probability to mark the closing shares at, usually as of end, keyed by answer ID for multiple choice markets and by market ID otherwise. Required.
+//   - start: The start of the period. Required.
+//   - end: The end of the period. Required.
+//
+// Returns:
+//   - []MarketInventory: The inventory of every market held or traded in the period, ordered by market and answer ID.
+//   - error: An error object if the mark of a market with closing shares is missing.
+func Inventory(bets []Bet, marks map[string]float64, start time.Time, end time.Time) ([]MarketInventory, error) {
+	startMs, endMs := start.UnixMilli(), end.UnixMilli()
+	byKey := make(map[string]*MarketInventory)
+
+	for _, bet := range bets {
+		if bet.IsRedemption || (bet.Outcome != "YES" && bet.Outcome != "NO") {
+			continue
+		}
+
+		key := bet.ContractID
+		if bet.AnswerID != nil {
+			key = *bet.AnswerID
+		}
+
+		for _, f := range betFills(bet) {
+			if f.timestamp >= endMs || f.shares == 0 {
+				continue
+			}
+
+			inventory, ok := byKey[key]
+			if !ok {
+				inventory = &MarketInventory{
+					ContractID: bet.ContractID,
+					AnswerID:   bet.AnswerID,
+					Opening:    make(map[string]float64),
+					Bought:     make(map[string]float64),
+					Sold:       make(map[string]float64),
+					Closing:    make(map[string]float64),
+				}
+				byKey[key] = inventory
+			}
+
+			inventory.Closing[bet.Outcome] += f.shares
+			switch {
+			case f.timestamp < startMs:
+				inventory.Opening[bet.Outcome] += f.shares
+			case f.shares > 0:
+				inventory.Bought[bet.Outcome] += f.shares
+			default:
+				inventory.Sold[bet.Outcome] -= f.shares
+			}
+		}
+	}
+
+	inventories := make([]MarketInventory, 0, len(byKey))
+	for key, inventory := range byKey {
+		inventory.Net = inventory.Closing["YES"] - inventory.Closing["NO"]
+
+		if inventory.Closing["YES"] != 0 || inventory.Closing["NO"] != 0 {
+			mark, ok := marks[key]
+			if !ok {
+				return nil, fmt.Errorf("Inventory: missing mark for %s", key)
+			}
+			inventory.Mark = mark
+			inventory.Value = inventory.Closing["YES"]*mark + inventory.Closing["NO"]*(1-mark)
+		}
+
+		inventories = append(inventories, *inventory)
+	}
+
+	sort.Slice(inventories, func(i, j int) bool {
+		a, b := inventories[i], inventories[j]
+		if a.ContractID != b.ContractID {
+			return a.ContractID < b.ContractID
+		}
+		return a.AnswerID != nil && (b.AnswerID == nil || *a.AnswerID < *b.AnswerID)
+	})
+
+	return inventories, nil
+}
+
+// Inventory fetches a user's bets and the probabilities of the markets they traded in as of end, and replays their
+// fills into the inventory they held in every market over [start, end) (see Inventory). Markets that had resolved by
+// end are marked at their resolution.
+//
+// Parameters:
+//   - userID: The ID of the user. Required.
+//   - start: The start of the period. Required.
+//   - end: The end of the period. Required.
+//
+// Returns:
+//   - []MarketInventory: The inventory of every market held or traded in the period.
+//   - error: An error object if a request fails or if the response cannot be parsed.
+func (s *BetService) Inventory(userID string, start time.Time, end time.Time) ([]MarketInventory, error) {
+	bets, err := s.allBets(&userID, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("Bet: Inventory: %w", err)
+	}
+
+	var traded []Bet
+	for _, bet := range bets {
+		if bet.CreatedTime < end.UnixMilli() {
+			traded = append(traded, bet)
+		}
+	}
+
+	marks, err := s.marksAt(traded, end)
+	if err != nil {
+		return nil, fmt.Errorf("Bet: Inventory: %w", err)
+	}
+
+	inventories, err := Inventory(traded, marks, start, end)
+	if err != nil {
+		return nil, fmt.Errorf("Bet: %w", err)
+	}
+
+	return inventories, nil
+}
+
+// marksAt fetches the probability at a point in time of every market (or answer) traded in by bets, keyed by answer ID
+// for multiple choice markets and by market ID otherwise. Those that had resolved by then are marked at their resolution.
+func (s *BetService) marksAt(bets []Bet, at time.Time) (map[string]float64, error) {
+	markets := make(map[string]*FullMarket)
+	marks, err := s.probabilitiesAt(bets, markets, at)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, bet := range bets {
+		market, ok := markets[bet.ContractID]
+		if !ok {
+			continue
+		}
+
+		resolution := marketResolution(market, bet.AnswerID)
+		if resolution == nil || resolution.time > at.UnixMilli() {
+			continue
+		}
+
+		key := bet.ContractID
+		if bet.AnswerID != nil {
+			key = *bet.AnswerID
+		}
+
+		switch {
+		case resolution.resolution == "YES":
+			marks[key] = 1
+		case resolution.resolution == "NO":
+			marks[key] = 0
+		case resolution.resolution == "MKT" && resolution.resolutionProb != nil:
+			marks[key] = *resolution.resolutionProb
+		}
+	}
+
+	return marks, nil
+}
@@ -0,0 +1,138 @@
+package manifold
+
+import (
+	"encoding/json"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestInventory(t *testing.T) {
+	start := time.Date(2026, time.March, 1, 0, 0, 0, 0, time.UTC)
+	at := func(d time.Duration) int64 { return start.Add(d).UnixMilli() }
+	end := start.Add(24 * time.Hour)
+
+	// A limit order placed before the period, filled partly before it, partly within it and partly after it.
+	limit := Bet{ContractID: "m", Outcome: "YES", CreatedTime: at(-time.Hour), LimitProps: &LimitProps{Fills: []Fill{
+		{Amount: 5, Shares: 10, Timestamp: at(-time.Minute)},
+		{Amount: 5, Shares: 10, Timestamp: at(time.Hour)},
+		{Amount: 5, Shares: 10, Timestamp: at(48 * time.Hour)},
+	}}}
+
+	bets := []Bet{
+		limit,
+		{ContractID: "m", Outcome: "NO", Amount: 4, Shares: 8, CreatedTime: at(2 * time.Hour)},
+		{ContractID: "m", Outcome: "YES", Amount: -3, Shares: -5, CreatedTime: at(3 * time.Hour)},
+		{ContractID: "closed", Outcome: "NO", Amount: 2, Shares: 4, CreatedTime: at(time.Hour)},
+		{ContractID: "closed", Outcome: "NO", Amount: -2, Shares: -4, CreatedTime: at(2 * time.Hour)},
+		{ContractID: "later", Outcome: "YES", Amount: 2, Shares: 4, CreatedTime: at(30 * time.Hour)},
+	}
+
+	inventories, err := Inventory(bets, map[string]float64{"m": 0.6}, start, end)
+	if err != nil {
+		t.Fatalf("Inventory() error = %v", err)
+	}
+
+	want := []MarketInventory{
+		{
+			ContractID: "closed",
+			Opening:    map[string]float64{},
+			Bought:     map[string]float64{"NO": 4},
+			Sold:       map[string]float64{"NO": 4},
+			Closing:    map[string]float64{"NO": 0},
+		},
+		{
+			ContractID: "m",
+			Opening:    map[string]float64{"YES": 10},
+			Bought:     map[string]float64{"YES": 10, "NO": 8},
+			Sold:       map[string]float64{"YES": 5},
+			Closing:    map[string]float64{"YES": 15, "NO": 8},
+			Net:        7,
+			Mark:       0.6,
+			Value:      15*0.6 + 8*0.4,
+		},
+	}
+	if len(inventories) == 2 {
+		inventories[1].Value = math.Round(inventories[1].Value*1e9) / 1e9
+		want[1].Value = math.Round(want[1].Value*1e9) / 1e9
+	}
+	if !reflect.DeepEqual(inventories, want) {
+		t.Errorf("Inventory() = %+v, want %+v", inventories, want)
+	}
+
+	if _, err := Inventory(bets, nil, start, end); err == nil {
+		t.Error("Inventory() without marks: error = nil, want a missing mark error")
+	}
+}
+
+func TestAttributePnL(t *testing.T) {
+	start := time.Date(2026, time.March, 1, 0, 0, 0, 0, time.UTC)
+	at := func(d time.Duration) int64 { return start.Add(d).UnixMilli() }
+
+	bets := []Bet{
+		// Bought 10 YES at 0.4 with the market at 0.5, marked at 0.7.
+		{ContractID: "m", Outcome: "YES", Amount: 4, Shares: 10, ProbBefore: 0.5, CreatedTime: at(time.Hour), Fees: Fees{PlatformFee: 0.5}},
+		// Bought 10 NO at 0.45 with NO at 0.5, marked at 0.3 for NO.
+		{ContractID: "m", Outcome: "NO", Amount: 4.5, Shares: 10, ProbBefore: 0.5, CreatedTime: at(2 * time.Hour)},
+		// Outside the period.
+		{ContractID: "m", Outcome: "YES", Amount: 4, Shares: 10, ProbBefore: 0.5, CreatedTime: at(-time.Hour)},
+	}
+
+	attributions, err := AttributePnL(bets, map[string]float64{"m": 0.7}, start, start.Add(24*time.Hour))
+	if err != nil {
+		t.Fatalf("AttributePnL() error = %v", err)
+	}
+	if len(attributions) != 1 {
+		t.Fatalf("AttributePnL() = %+v, want one market", attributions)
+	}
+
+	got := attributions[0]
+	if got.Fills != 2 || got.Volume != 8.5 || got.Fees != 0.5 ||
+		math.Abs(got.SpreadCapture-1.5) > 1e-9 || math.Abs(got.AdverseSelection-0) > 1e-9 || math.Abs(got.Total-1) > 1e-9 {
+		t.Errorf("AttributePnL() = %+v, want 2 fills, 8.5 volume, 1.5 spread capture, 0 adverse selection, 1 total", got)
+	}
+}
+
+func TestPnLAttributionMarksAtEnd(t *testing.T) {
+	start := time.Date(2026, time.March, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(24 * time.Hour)
+	bet := Bet{ID: "b", UserID: "u1", ContractID: "m", Outcome: "YES", Amount: 5, Shares: 10, ProbBefore: 0.5, ProbAfter: 0.55,
+		CreatedTime: start.Add(time.Hour).UnixMilli()}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/bets":
+			query := r.URL.Query()
+			if query.Get("userId") == "u1" {
+				json.NewEncoder(w).Encode([]Bet{bet})
+				return
+			}
+			// The last bet on the market before the end of the period left it at 0.6.
+			if query.Get("beforeTime") == "" {
+				t.Errorf("market history requested without beforeTime: %s", r.URL)
+			}
+			json.NewEncoder(w).Encode([]Bet{{ID: "other", ContractID: "m", ProbAfter: 0.6, CreatedTime: end.Add(-time.Hour).UnixMilli()}})
+		case "/market/m":
+			w.Write([]byte(`{"id":"m","probability":0.9}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("key")
+	client.BaseURL = server.URL
+
+	attributions, err := client.Bet.PnLAttribution("u1", start, end)
+	if err != nil {
+		t.Fatalf("PnLAttribution() error = %v", err)
+	}
+
+	// Marked at 0.6 as of the end of the period, not at the current 0.9.
+	if len(attributions) != 1 || math.Abs(attributions[0].AdverseSelection-1) > 1e-9 {
+		t.Errorf("PnLAttribution() = %+v, want adverse selection of 10 * (0.6 - 0.5)", attributions)
+	}
+}
@@ -0,0 +1,230 @@
+package manifold
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// MarketSettings is a snapshot of the settings of a market that its creator controls.
+type MarketSettings struct {
+	ContractID      string   `json:"contractId"`          // ID of the market
+	Question        string   `json:"question"`            // Question of the market
+	CloseTime       *int64   `json:"closeTime,omitempty"` // Close time of the market (optional)
+	GroupSlugs      []string `json:"groupSlugs"`          // Slugs of the market's topics, sorted
+	Visibility      string   `json:"visibility"`          // Visibility of the market
+	DescriptionHash string   `json:"descriptionHash"`     // SHA-256 of the market's description
+}
+
+// SettingsChange is a difference between two snapshots of a market's settings.
+type SettingsChange struct {
+	ContractID string // ID of the market
+	Field      string // Name of the setting that changed, e.g. "closeTime" or "groupSlugs"
+	Before     string // Previous value, formatted for display
+	After      string // Current value, formatted for display
+}
+
+// String returns a one-line description of the change.
+func (c SettingsChange) String() string {
+	return fmt.Sprintf("%s: %s changed from %q to %q", c.ContractID, c.Field, c.Before, c.After)
+}
+
+// SnapshotSettings takes a snapshot of the settings of a market.
+//
+// Parameters:
+//   - market: The market. Required.
+//
+// Returns:
+//   - MarketSettings: The snapshot of the market's settings.
+func SnapshotSettings(market *FullMarket) MarketSettings {
+	groups := make([]string, 0)
+	if market.GroupSlugs != nil {
+		groups = append(groups, *market.GroupSlugs...)
+	}
+	sort.Strings(groups)
+
+	hash := sha256.Sum256(market.Description)
+
+	return MarketSettings{
+		ContractID:      market.ID,
+		Question:        market.Question,
+		CloseTime:       market.CloseTime,
+		GroupSlugs:      groups,
+		Visibility:      valueOr(market.Visibility, "public"),
+		DescriptionHash: hex.EncodeToString(hash[:]),
+	}
+}
+
+// DiffSettings compares two snapshots of a market's settings.
+//
+// Parameters:
+//   - before: The earlier snapshot. Required.
+//   - after: The later snapshot. Required.
+//
+// Returns:
+//   - []SettingsChange: The settings that changed, in a fixed order.
+func DiffSettings(before MarketSettings, after MarketSettings) []SettingsChange {
+	var changes []SettingsChange
+	add := func(field, a, b string) {
+		if a != b {
+			changes = append(changes, SettingsChange{ContractID: after.ContractID, Field: field, Before: a, After: b})
+		}
+	}
+	closeTime := func(t *int64) string {
+		if t == nil {
+			return ""
+		}
+		return time.UnixMilli(*t).UTC().Format(time.RFC3339)
+	}
+
+	add("question", before.Question, after.Question)
+	add("closeTime", closeTime(before.CloseTime), closeTime(after.CloseTime))
+	add("groupSlugs", strings.Join(before.GroupSlugs, ","), strings.Join(after.GroupSlugs, ","))
+	add("visibility", before.Visibility, after.Visibility)
+	add("description", before.DescriptionHash, after.DescriptionHash)
+
+	return changes
+}
+
+// SettingsAuditor snapshots the settings of a creator's open markets and reports changes between polls, such as
+// a moderator re-tagging a market or editing its description. Changes made on purpose are reported too, so
+// callers that make them can Expect them first.
+type SettingsAuditor struct {
+	client    *Client
+	creatorID string
+	onChange  func(SettingsChange)
+
+	mu        sync.Mutex
+	snapshots map[string]MarketSettings
+}
+
+// NewSettingsAuditor creates a new settings auditor.
+//
+// Parameters:
+//   - client: The client used to poll markets. Required.
+//   - creatorID: The ID of the user whose markets are audited. Required.
+//   - snapshots: Snapshots from a previous run, e.g. as returned by Snapshots and persisted. Optional.
+//   - onChange: Called for every detected change, e.g. to send a notification. Required.
+//
+// Returns:
+//   - *SettingsAuditor: A pointer to the newly created auditor.
+func NewSettingsAuditor(client *Client, creatorID string, snapshots []MarketSettings, onChange func(SettingsChange)) *SettingsAuditor {
+	a := &SettingsAuditor{
+		client:    client,
+		creatorID: creatorID,
+		onChange:  onChange,
+		snapshots: make(map[string]MarketSettings, len(snapshots)),
+	}
+
+	for _, snapshot := range snapshots {
+		a.snapshots[snapshot.ContractID] = snapshot
+	}
+
+	return a
+}
+
+// Snapshots returns the latest snapshot of every audited market, ordered by market ID.
+//
+// Returns:
+//   - []MarketSettings: The snapshots, suitable for persisting and passing to NewSettingsAuditor.
+func (a *SettingsAuditor) Snapshots() []MarketSettings {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	snapshots := make([]MarketSettings, 0, len(a.snapshots))
+	for _, snapshot := range a.snapshots {
+		snapshots = append(snapshots, snapshot)
+	}
+
+	sort.Slice(snapshots, func(i, j int) bool {
+		return snapshots[i].ContractID < snapshots[j].ContractID
+	})
+
+	return snapshots
+}
+
+// Expect replaces the snapshot of a market, so that a change the caller is about to make is not reported.
+//
+// Parameters:
+//   - settings: The settings the market is expected to have. Required.
+func (a *SettingsAuditor) Expect(settings MarketSettings) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.snapshots[settings.ContractID] = settings
+}
+
+// Check polls the creator's unresolved markets once and reports changes since their previous snapshot.
+// Markets seen for the first time are snapshotted without reporting anything, and resolved markets are dropped.
+//
+// Returns:
+//   - error: An error object if a market could not be polled. Remaining markets are still checked.
+func (a *SettingsAuditor) Check() error {
+	limit := 1000
+	markets, err := a.client.Market.Markets(&limit, nil, nil, nil, &a.creatorID, nil)
+	if err != nil {
+		return fmt.Errorf("SettingsAuditor: Check: %w", err)
+	}
+
+	var firstErr error
+	for _, lite := range markets {
+		if lite.IsResolved {
+			a.mu.Lock()
+			delete(a.snapshots, lite.ID)
+			a.mu.Unlock()
+			continue
+		}
+
+		market, err := a.client.Market.Market(lite.ID)
+		if err != nil {
+			if firstErr == nil {
+				firstErr = fmt.Errorf("SettingsAuditor: Check: %w", err)
+			}
+			continue
+		}
+
+		current := SnapshotSettings(market)
+
+		a.mu.Lock()
+		previous, ok := a.snapshots[market.ID]
+		a.snapshots[market.ID] = current
+		a.mu.Unlock()
+
+		if !ok {
+			continue
+		}
+
+		for _, change := range DiffSettings(previous, current) {
+			a.onChange(change)
+		}
+	}
+
+	return firstErr
+}
+
+// Run calls Check at the given interval until stop is closed. Errors from Check are passed to onError.
+//
+// Parameters:
+//   - interval: The time between checks. Required.
+//   - stop: A channel that stops the auditor when closed. Required.
+//   - onError: Called with every error returned by Check. Optional.
+func (a *SettingsAuditor) Run(interval time.Duration, stop <-chan struct{}, onError func(error)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := a.Check(); err != nil && onError != nil {
+			onError(err)
+		}
+
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,160 @@
+package manifold
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// BankrollPolicy limits how much of a user's balance may be concentrated in a single market or topic.
+// Limits are relative to the current balance and are evaluated against the live portfolio before each spend.
+// Intentional exceptions are made with single-use override tokens.
+type BankrollPolicy struct {
+	maxMarketFraction float64
+	maxTopicFraction  float64
+
+	mu        sync.Mutex
+	overrides map[string]string
+}
+
+// NewBankrollPolicy creates a new bankroll policy.
+//
+// Parameters:
+//   - maxMarketFraction: The maximum exposure to a single market, as a fraction of the balance (e.g. 0.05). 0 disables the limit.
+//   - maxTopicFraction: The maximum exposure to a single topic, as a fraction of the balance (e.g. 0.2). 0 disables the limit.
+//
+// Returns:
+//   - *BankrollPolicy: A pointer to the newly created bankroll policy.
+func NewBankrollPolicy(maxMarketFraction float64, maxTopicFraction float64) *BankrollPolicy {
+	return &BankrollPolicy{
+		maxMarketFraction: maxMarketFraction,
+		maxTopicFraction:  maxTopicFraction,
+		overrides:         make(map[string]string),
+	}
+}
+
+// Override issues a single-use token that lets the next spend on a market bypass the policy.
+//
+// Parameters:
+//   - contractID: The ID of the market the exception is for. Required.
+//
+// Returns:
+//   - string: The override token, to pass to Check.
+func (p *BankrollPolicy) Override(contractID string) string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	token := hex.EncodeToString(b)
+
+	p.mu.Lock()
+	p.overrides[token] = contractID
+	p.mu.Unlock()
+
+	return token
+}
+
+// Check checks whether spending an amount on a market would keep the portfolio within the policy.
+// A valid override token for the market is consumed and bypasses the check.
+//
+// Parameters:
+//   - portfolio: The current portfolio of the user. Required.
+//   - balance: The current balance of the user in the token the market trades in. Required.
+//   - market: The market to spend on. Required.
+//   - amount: The amount to spend. Required.
+//   - override: An override token issued for the market. Optional.
+//
+// Returns:
+//   - error: ErrorBankrollPolicy if a limit would be exceeded.
+func (p *BankrollPolicy) Check(portfolio *Portfolio, balance float64, market *FullMarket, amount float64, override *string) error {
+	if override != nil {
+		p.mu.Lock()
+		contractID, ok := p.overrides[*override]
+		if ok && contractID == market.ID {
+			delete(p.overrides, *override)
+		}
+		p.mu.Unlock()
+
+		if ok && contractID == market.ID {
+			return nil
+		}
+	}
+
+	if p.maxMarketFraction > 0 {
+		exposure := amount
+		for _, position := range portfolio.Positions {
+			if position.ContractID == market.ID {
+				exposure += position.Payout
+			}
+		}
+
+		if limit := p.maxMarketFraction * balance; exposure > limit {
+			return fmt.Errorf("%w: exposure of %.2f to market %s exceeds %.2f", ErrorBankrollPolicy, exposure, market.ID, limit)
+		}
+	}
+
+	if p.maxTopicFraction > 0 && market.GroupSlugs != nil {
+		// Only positions in the same token as the market count against the balance.
+		sameToken := &Portfolio{UserID: portfolio.UserID, Markets: portfolio.Markets}
+		for _, position := range portfolio.Positions {
+			if m, ok := portfolio.Markets[position.ContractID]; ok && m.TokenOf() == market.TokenOf() {
+				sameToken.Positions = append(sameToken.Positions, position)
+			}
+		}
+
+		exposures := make(map[string]float64)
+		for _, topic := range sameToken.Topics() {
+			exposures[topic.Topic] = topic.Exposure
+		}
+
+		for _, topic := range *market.GroupSlugs {
+			exposure := exposures[topic] + amount
+			if limit := p.maxTopicFraction * balance; exposure > limit {
+				return fmt.Errorf("%w: exposure of %.2f to topic %s exceeds %.2f", ErrorBankrollPolicy, exposure, topic, limit)
+			}
+		}
+	}
+
+	return nil
+}
+
+// CreateWithPolicy places a bet as the authenticated user after checking it against a bankroll policy,
+// using their current balance and live portfolio.
+//
+// Parameters:
+//   - policy: The bankroll policy to enforce. Required.
+//   - amount: The amount of the bet. Required.
+//   - contractID: The ID of the contract on which the bet is being placed. Required.
+//   - outcome: The outcome of the bet (e.g., "YES" or "NO"). Optional.
+//   - limitProb: Probability threshold for a limit order. Must be between 0 and 1. Optional.
+//   - expiresAt: Expiration time for a limit order. Only valid if limitProb is set. Optional.
+//   - override: An override token issued by the policy for the market. Optional.
+//
+// Returns:
+//   - *Bet: The created bet object.
+//   - error: ErrorBankrollPolicy if the bet would exceed the policy, or an error object if a request fails.
+func (s *BetService) CreateWithPolicy(policy *BankrollPolicy, amount float64, contractID string, outcome *string, limitProb *float64, expiresAt *time.Time, override *string) (*Bet, error) {
+	me, err := s.client.User.Me()
+	if err != nil {
+		return nil, fmt.Errorf("Bet: CreateWithPolicy: %w", err)
+	}
+
+	portfolio, err := s.client.User.Portfolio(me.ID)
+	if err != nil {
+		return nil, fmt.Errorf("Bet: CreateWithPolicy: %w", err)
+	}
+
+	market, ok := portfolio.Markets[contractID]
+	if !ok {
+		market, err = s.client.Market.Market(contractID)
+		if err != nil {
+			return nil, fmt.Errorf("Bet: CreateWithPolicy: %w", err)
+		}
+	}
+
+	if err := policy.Check(portfolio, me.Balances()[market.TokenOf()], market, amount, override); err != nil {
+		return nil, fmt.Errorf("Bet: CreateWithPolicy: %w", err)
+	}
+
+	return s.Create(amount, contractID, outcome, limitProb, expiresAt, nil)
+}
@@ -0,0 +1,187 @@
+package manifold
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+// BetHistory keeps a local, append-only copy of the bet history of tracked markets, updated incrementally so that
+// analytics do not need to download the full history on every run. Bets are stored in order of placement.
+//
+// Bets are only fetched once, so later changes to a bet, such as fills on a resting limit order, are not reflected.
+type BetHistory struct {
+	client *Client
+
+	mu      sync.Mutex
+	markets map[string][]Bet
+}
+
+// NewBetHistory creates a new, empty bet history.
+//
+// Parameters:
+//   - client: The client used to fetch bets. Required.
+//
+// Returns:
+//   - *BetHistory: A pointer to the newly created bet history, with no markets tracked.
+func NewBetHistory(client *Client) *BetHistory {
+	return &BetHistory{
+		client:  client,
+		markets: make(map[string][]Bet),
+	}
+}
+
+// Track starts tracking a market, optionally seeded with bets stored from a previous run.
+// Syncing continues after the most recent seeded bet.
+//
+// Parameters:
+//   - contractID: The ID of the market to track. Required.
+//   - seed: Bets of the market stored from a previous run, in any order. Optional.
+func (h *BetHistory) Track(contractID string, seed []Bet) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	bets := append(h.markets[contractID], seed...)
+	sort.SliceStable(bets, func(i, j int) bool {
+		return bets[i].CreatedTime < bets[j].CreatedTime
+	})
+
+	h.markets[contractID] = bets
+}
+
+// Sync fetches the bets placed on every tracked market since the last sync.
+//
+// Returns:
+//   - error: An error object if a request fails. Markets synced before the failure keep their new bets.
+func (h *BetHistory) Sync() error {
+	h.mu.Lock()
+	ids := make([]string, 0, len(h.markets))
+	for id := range h.markets {
+		ids = append(ids, id)
+	}
+	h.mu.Unlock()
+
+	for _, id := range ids {
+		if err := h.sync(id); err != nil {
+			return fmt.Errorf("BetHistory: Sync: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// sync fetches the new bets of a single market.
+func (h *BetHistory) sync(contractID string) error {
+	h.mu.Lock()
+	var after *string
+	if bets := h.markets[contractID]; len(bets) > 0 {
+		after = &bets[len(bets)-1].ID
+	}
+	h.mu.Unlock()
+
+	var (
+		limit = 1000
+		order = "asc"
+		fresh []Bet
+	)
+	for {
+		page, err := h.client.Bet.Bets(nil, nil, &contractID, nil, &limit, nil, after, nil, nil, nil, &order)
+		if err != nil {
+			return err
+		}
+
+		fresh = append(fresh, page...)
+		if len(page) < limit {
+			break
+		}
+
+		after = &page[len(page)-1].ID
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if _, ok := h.markets[contractID]; ok {
+		h.markets[contractID] = append(h.markets[contractID], fresh...)
+	}
+
+	return nil
+}
+
+// Bets returns a copy of the stored bet history of a market, in order of placement.
+//
+// Parameters:
+//   - contractID: The ID of the market. Required.
+//
+// Returns:
+//   - []Bet: The stored bets of the market.
+func (h *BetHistory) Bets(contractID string) []Bet {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	bets := make([]Bet, len(h.markets[contractID]))
+	copy(bets, h.markets[contractID])
+
+	return bets
+}
+
+// Between returns the stored bets of a market placed within [start, end), in order of placement.
+//
+// Parameters:
+//   - contractID: The ID of the market. Required.
+//   - start: The start of the interval. Required.
+//   - end: The end of the interval. Required.
+//
+// Returns:
+//   - []Bet: The bets placed within the interval.
+func (h *BetHistory) Between(contractID string, start time.Time, end time.Time) []Bet {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	bets := h.markets[contractID]
+	from := sort.Search(len(bets), func(i int) bool {
+		return bets[i].CreatedTime >= start.UnixMilli()
+	})
+	to := sort.Search(len(bets), func(i int) bool {
+		return bets[i].CreatedTime >= end.UnixMilli()
+	})
+
+	if from >= to {
+		return nil
+	}
+
+	between := make([]Bet, to-from)
+	copy(between, bets[from:to])
+
+	return between
+}
+
+// VolumePerHour returns the traded volume of a market in each hour of [start, end), counting sales as volume too.
+//
+// Parameters:
+//   - contractID: The ID of the market. Required.
+//   - start: The start of the first hour. Required.
+//   - end: The end of the interval. Required.
+//
+// Returns:
+//   - []float64: The volume of every hour, starting with the hour beginning at start.
+func (h *BetHistory) VolumePerHour(contractID string, start time.Time, end time.Time) []float64 {
+	if !end.After(start) {
+		return nil
+	}
+
+	hours := int((end.Sub(start) + time.Hour - 1) / time.Hour)
+	volume := make([]float64, hours)
+
+	for _, bet := range h.Between(contractID, start, end) {
+		hour := int(time.UnixMilli(bet.CreatedTime).Sub(start) / time.Hour)
+		if bet.Amount < 0 {
+			volume[hour] -= bet.Amount
+		} else {
+			volume[hour] += bet.Amount
+		}
+	}
+
+	return volume
+}
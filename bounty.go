@@ -0,0 +1,147 @@
+package manifold
+
+import (
+	"fmt"
+	"sort"
+)
+
+// BountyAward is a single award of a BountyPlan.
+type BountyAward struct {
+	CommentID string  // ID of the comment to award
+	Amount    float64 // Amount to award
+	Reply     *string // Markdown acknowledgment to post on the market after awarding (optional)
+}
+
+// BountyPlan is a reviewable set of awards on a bountied question, created by PlanBounty and carried out by ApplyBounty.
+type BountyPlan struct {
+	ContractID string        // ID of the market
+	BountyLeft float64       // Bounty left before the plan is applied
+	Awards     []BountyAward // Awards to make, in order
+	Remaining  float64       // Bounty left after the plan is applied
+}
+
+// BountyResult is the outcome of applying a single award.
+type BountyResult struct {
+	Award BountyAward // The award that was applied
+	Txn   *Txn        // Transaction of the award
+}
+
+// BountyCandidates retrieves the comments on a bountied question posted after the most recently awarded comment,
+// excluding hidden comments and those of the market's creator.
+//
+// Parameters:
+//   - id: The ID of the market. Required.
+//
+// Returns:
+//   - []Comment: The candidate comments, oldest first.
+//   - error: An error object if a request fails or if the response cannot be parsed.
+func (s *MarketService) BountyCandidates(id string) ([]Comment, error) {
+	market, err := s.Market(id)
+	if err != nil {
+		return nil, fmt.Errorf("Market: BountyCandidates: %w", err)
+	}
+
+	var (
+		comments []Comment
+		limit    = 1000
+		offset   = 0
+	)
+
+	for {
+		page, err := s.client.Comment.Comments(&id, nil, &limit, &offset, nil)
+		if err != nil {
+			return nil, fmt.Errorf("Market: BountyCandidates: %w", err)
+		}
+
+		comments = append(comments, page...)
+		if len(page) < limit {
+			break
+		}
+		offset += len(page)
+	}
+
+	sort.Slice(comments, func(i, j int) bool {
+		return comments[i].CreatedTime < comments[j].CreatedTime
+	})
+
+	var lastAward int64
+	for _, comment := range comments {
+		if comment.BountyAwarded != nil && *comment.BountyAwarded > 0 && comment.CreatedTime > lastAward {
+			lastAward = comment.CreatedTime
+		}
+	}
+
+	candidates := make([]Comment, 0)
+	for _, comment := range comments {
+		if comment.CreatedTime <= lastAward || comment.UserID == market.CreatorID || (comment.Hidden != nil && *comment.Hidden) {
+			continue
+		}
+
+		candidates = append(candidates, comment)
+	}
+
+	return candidates, nil
+}
+
+// PlanBounty validates a set of awards against the bounty left on a bountied question, without awarding anything.
+//
+// Parameters:
+//   - id: The ID of the market. Required.
+//   - awards: The awards to make. Every amount must be greater than zero. Required.
+//
+// Returns:
+//   - *BountyPlan: A pointer to the plan, to be reviewed and passed to ApplyBounty.
+//   - error: An error object if the request fails, the market is not a bountied question, or the awards exceed the bounty left.
+func (s *MarketService) PlanBounty(id string, awards []BountyAward) (*BountyPlan, error) {
+	market, err := s.Market(id)
+	if err != nil {
+		return nil, fmt.Errorf("Market: PlanBounty: %w", err)
+	}
+
+	if market.OutcomeType != "BOUNTIED_QUESTION" || market.BountyLeft == nil {
+		return nil, fmt.Errorf("Market: PlanBounty: market %s is not a bountied question", id)
+	}
+
+	plan := &BountyPlan{ContractID: id, BountyLeft: *market.BountyLeft, Awards: awards, Remaining: *market.BountyLeft}
+	for _, award := range awards {
+		if award.Amount <= 0 {
+			return nil, fmt.Errorf("Market: PlanBounty(awards): invalid amount %f for comment %s, must be >0", award.Amount, award.CommentID)
+		}
+
+		plan.Remaining -= award.Amount
+	}
+
+	if plan.Remaining < 0 {
+		return nil, fmt.Errorf("Market: PlanBounty(awards): awards exceed the bounty left of %.2f", plan.BountyLeft)
+	}
+
+	return plan, nil
+}
+
+// ApplyBounty carries out a plan created by PlanBounty, awarding every comment in order and posting its acknowledgment.
+//
+// Parameters:
+//   - plan: The plan to apply. Required.
+//
+// Returns:
+//   - []BountyResult: The awards applied, in order. On failure, the awards applied before it.
+//   - error: An error object if an award or acknowledgment fails. Later awards are not applied.
+func (s *MarketService) ApplyBounty(plan *BountyPlan) ([]BountyResult, error) {
+	results := make([]BountyResult, 0, len(plan.Awards))
+
+	for _, award := range plan.Awards {
+		txn, err := s.AwardBounty(plan.ContractID, award.Amount, award.CommentID)
+		if err != nil {
+			return results, fmt.Errorf("Market: ApplyBounty: %w", err)
+		}
+		results = append(results, BountyResult{Award: award, Txn: txn})
+
+		if award.Reply != nil {
+			if err := s.client.Comment.CommentMarkdown(plan.ContractID, *award.Reply); err != nil {
+				return results, fmt.Errorf("Market: ApplyBounty: %w", err)
+			}
+		}
+	}
+
+	return results, nil
+}
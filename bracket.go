@@ -0,0 +1,166 @@
+package manifold
+
+import (
+	"fmt"
+	"math"
+	"sync"
+	"time"
+)
+
+// BracketEntry is one outcome of a bracket, backed by a binary market or an answer of a multiple choice market.
+type BracketEntry struct {
+	Label      string  // Display label of the outcome, e.g. a candidate name
+	ContractID string  // ID of the market backing the outcome
+	AnswerID   *string // ID of the answer backing the outcome, for multiple choice markets (optional)
+}
+
+// BracketRow is the probability of a single outcome in a BracketTable.
+type BracketRow struct {
+	Label       string  // Display label of the outcome
+	Probability float64 // Probability reported by the backing market
+	Value       float64 // Probability after renormalization, or the raw probability if renormalization is disabled
+	Change      float64 // Change in Value since the previous snapshot
+}
+
+// BracketTable is a snapshot of every outcome of a bracket.
+type BracketTable struct {
+	Time       time.Time    // Time the snapshot was taken
+	Rows       []BracketRow // Outcomes, in the order of the bracket entries
+	Sum        float64      // Sum of the raw probabilities
+	Consistent bool         // Whether Sum is within the configured tolerance of one
+}
+
+// Bracket aggregates a set of mutually exclusive outcomes spread across markets, such as one market per candidate,
+// into a single probability table, and tracks how it changes between snapshots.
+type Bracket struct {
+	client      *Client
+	entries     []BracketEntry
+	tolerance   float64
+	renormalize bool
+
+	mu       sync.Mutex
+	previous map[string]float64
+}
+
+// NewBracket creates a new bracket aggregator.
+//
+// Parameters:
+//   - client: The client used to fetch the markets. Required.
+//   - entries: The outcomes of the bracket. Labels must be unique. Required.
+//   - tolerance: How far the sum of probabilities may be from one for the table to be consistent. Required.
+//   - renormalize: If true, probabilities are scaled to sum to one in the table values.
+//
+// Returns:
+//   - *Bracket: A pointer to the newly created bracket.
+//   - error: An error object if input validation fails.
+func NewBracket(client *Client, entries []BracketEntry, tolerance float64, renormalize bool) (*Bracket, error) {
+	if len(entries) == 0 {
+		return nil, fmt.Errorf("Bracket: NewBracket: at least one entry is required")
+	}
+
+	labels := make(map[string]bool, len(entries))
+	for _, entry := range entries {
+		if labels[entry.Label] {
+			return nil, fmt.Errorf("Bracket: NewBracket: duplicate label %q", entry.Label)
+		}
+		labels[entry.Label] = true
+	}
+
+	if tolerance < 0 {
+		return nil, fmt.Errorf("Bracket: NewBracket(tolerance): invalid value: %f, must be greater than 0", tolerance)
+	}
+
+	return &Bracket{
+		client:      client,
+		entries:     entries,
+		tolerance:   tolerance,
+		renormalize: renormalize,
+	}, nil
+}
+
+// Snapshot fetches the current probability of every outcome and builds a table, including the change since the previous snapshot.
+//
+// Returns:
+//   - *BracketTable: A pointer to the snapshot.
+//   - error: An error object if a request fails or if an outcome has no probability.
+func (b *Bracket) Snapshot() (*BracketTable, error) {
+	markets := make(map[string]*FullMarket)
+	probs := make([]float64, len(b.entries))
+
+	for i, entry := range b.entries {
+		market, ok := markets[entry.ContractID]
+		if !ok {
+			var err error
+			market, err = b.client.Market.Market(entry.ContractID)
+			if err != nil {
+				return nil, fmt.Errorf("Bracket: Snapshot: %w", err)
+			}
+			markets[entry.ContractID] = market
+		}
+
+		prob, err := entryProbability(market, entry)
+		if err != nil {
+			return nil, fmt.Errorf("Bracket: Snapshot: %w", err)
+		}
+		probs[i] = prob
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	table := buildBracketTable(b.entries, probs, b.tolerance, b.renormalize, b.previous)
+
+	b.previous = make(map[string]float64, len(table.Rows))
+	for _, row := range table.Rows {
+		b.previous[row.Label] = row.Value
+	}
+
+	return table, nil
+}
+
+// entryProbability returns the probability of a bracket entry from its backing market.
+func entryProbability(market *FullMarket, entry BracketEntry) (float64, error) {
+	if entry.AnswerID == nil {
+		if market.Probability == nil {
+			return 0, fmt.Errorf("market %s has no probability", market.ID)
+		}
+
+		return *market.Probability, nil
+	}
+
+	if market.Answers != nil {
+		for _, answer := range *market.Answers {
+			if answer.ID == *entry.AnswerID {
+				return answer.Probability, nil
+			}
+		}
+	}
+
+	return 0, fmt.Errorf("market %s has no answer %s", market.ID, *entry.AnswerID)
+}
+
+// buildBracketTable builds a bracket table from the raw probability of every entry.
+func buildBracketTable(entries []BracketEntry, probs []float64, tolerance float64, renormalize bool, previous map[string]float64) *BracketTable {
+	table := &BracketTable{Time: time.Now(), Rows: make([]BracketRow, len(entries))}
+
+	for _, prob := range probs {
+		table.Sum += prob
+	}
+	table.Consistent = math.Abs(table.Sum-1) <= tolerance
+
+	for i, entry := range entries {
+		value := probs[i]
+		if renormalize && table.Sum > 0 {
+			value /= table.Sum
+		}
+
+		row := BracketRow{Label: entry.Label, Probability: probs[i], Value: value}
+		if prev, ok := previous[entry.Label]; ok {
+			row.Change = value - prev
+		}
+
+		table.Rows[i] = row
+	}
+
+	return table
+}
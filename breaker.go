@@ -0,0 +1,100 @@
+package manifold
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// circuitBreaker stops requests to the API after consecutive failures, until a cooldown has passed. After the
+// cooldown a single request is let through to probe the API: success closes the circuit, failure reopens it.
+type circuitBreaker struct {
+	threshold int
+	cooldown  time.Duration
+
+	mu       sync.Mutex
+	failures int
+	openedAt time.Time
+	open     bool
+	probing  bool
+}
+
+// WithCircuitBreaker makes the client fail fast with ErrorCircuitOpen after a number of consecutive failed
+// requests, for a cooldown period. Network errors and 5xx responses count as failures.
+//
+// Parameters:
+//   - threshold: The number of consecutive failures that opens the circuit. Must be greater than 0. Required.
+//   - cooldown: How long the circuit stays open before a request is let through again. Required.
+//
+// Returns:
+//   - Option: An option to pass to NewClient.
+func WithCircuitBreaker(threshold int, cooldown time.Duration) Option {
+	return func(c *Client) {
+		if threshold > 0 {
+			c.breaker = &circuitBreaker{threshold: threshold, cooldown: cooldown}
+		}
+	}
+}
+
+// CircuitOpen reports whether requests are currently failing fast because of the circuit breaker.
+//
+// Returns:
+//   - bool: Whether the circuit is open. Always false if no circuit breaker is set.
+func (c *Client) CircuitOpen() bool {
+	if c.breaker == nil {
+		return false
+	}
+
+	c.breaker.mu.Lock()
+	defer c.breaker.mu.Unlock()
+
+	return c.breaker.open
+}
+
+// allow checks whether a request may be sent, letting a single probe through once the cooldown has passed.
+func (b *circuitBreaker) allow(now time.Time) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if !b.open {
+		return nil
+	}
+
+	remaining := b.cooldown - now.Sub(b.openedAt)
+	if remaining > 0 {
+		return fmt.Errorf("%w: %d consecutive failures, retrying in %v", ErrorCircuitOpen, b.failures, remaining.Round(time.Second))
+	}
+
+	if b.probing {
+		return fmt.Errorf("%w: %d consecutive failures, probing the API", ErrorCircuitOpen, b.failures)
+	}
+
+	b.probing = true
+
+	return nil
+}
+
+// record records the outcome of a request.
+func (b *circuitBreaker) record(err error, now time.Time) {
+	var apiErr *APIError
+	failed := err != nil && (!errors.As(err, &apiErr) || apiErr.StatusCode >= http.StatusInternalServerError) &&
+		!errors.Is(err, ErrorRateLimited)
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.probing = false
+	if !failed {
+		b.failures = 0
+		b.open = false
+		return
+	}
+
+	b.failures++
+	if b.open || b.failures >= b.threshold {
+		b.open = true
+		b.openedAt = now
+	}
+}
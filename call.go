@@ -0,0 +1,97 @@
+package manifold
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// Call sends a request to any endpoint of the Manifold API and decodes the JSON response, as an escape hatch for
+// endpoints that are not wrapped by a service yet. The request goes through the same authentication, rate limit
+// retries, circuit breaker, logging and error handling as every other request.
+//
+// Parameters:
+//   - method: The HTTP method, e.g. "GET" or "POST". Required.
+//   - endpoint: The API endpoint to send the request to (relative to BaseURL). Required.
+//   - params: A map of query parameters to include in the request. Optional.
+//   - body: The body to include in the request. Must be serializable to JSON. Optional.
+//   - out: A pointer to decode the JSON response into. If nil, the response is discarded. Optional.
+//
+// Returns:
+//   - error: An error object if the request fails, if the API responds with a non-2xx status (*APIError), or if the response cannot be decoded.
+func (c *Client) Call(method string, endpoint string, params map[string]string, body interface{}, out interface{}) error {
+	_, err := c.CallWithMeta(method, endpoint, params, body, out)
+	return err
+}
+
+// CallWithMeta is like Call, but also returns the metadata of the response, such as the request ID to quote in
+// support tickets and the remaining rate limit. Unlike Client.LastResponse, the metadata always belongs to this request.
+//
+// Parameters:
+//   - method: The HTTP method, e.g. "GET" or "POST". Required.
+//   - endpoint: The API endpoint to send the request to (relative to BaseURL). Required.
+//   - params: A map of query parameters to include in the request. Optional.
+//   - body: The body to include in the request. Must be serializable to JSON. Optional.
+//   - out: A pointer to decode the JSON response into. If nil, the response is discarded. Optional.
+//
+// Returns:
+//   - *ResponseMeta: A pointer to the metadata of the response, or nil if no response was received.
+//   - error: An error object if the request fails, if the API responds with a non-2xx status (*APIError), or if the response cannot be decoded.
+func (c *Client) CallWithMeta(method string, endpoint string, params map[string]string, body interface{}, out interface{}) (*ResponseMeta, error) {
+	var (
+		reader   io.Reader
+		jsonBody []byte
+		err      error
+	)
+	if body != nil {
+		jsonBody, err = json.Marshal(body)
+		if err != nil {
+			return nil, fmt.Errorf("Call(%s %s): %w", method, endpoint, err)
+		}
+		reader = bytes.NewBuffer(jsonBody)
+	}
+
+	req, err := http.NewRequest(method, fmt.Sprintf("%s%s", c.BaseURL, endpoint), reader)
+	if err != nil {
+		return nil, fmt.Errorf("Call(%s %s): %w", method, endpoint, err)
+	}
+
+	q := req.URL.Query()
+	for key, value := range params {
+		q.Add(key, value)
+	}
+	req.URL.RawQuery = q.Encode()
+
+	if body != nil {
+		req.Header.Add("Content-Type", "application/json")
+	}
+	if c.APIKey != "" {
+		req.Header.Add("Authorization", fmt.Sprintf("Key %s", c.APIKey))
+	}
+
+	// Query parameters and body fields are both reported in an APIError.
+	reported := bodyParams(jsonBody)
+	if reported == nil {
+		reported = make(map[string]string, len(params))
+	}
+	for key, value := range params {
+		reported[key] = value
+	}
+
+	result, meta, err := c.do(endpoint, reported, req)
+	if err != nil {
+		return meta, fmt.Errorf("Call(%s %s): %w", method, endpoint, err)
+	}
+
+	if out == nil || len(bytes.TrimSpace(result)) == 0 {
+		return meta, nil
+	}
+
+	if err := json.Unmarshal(result, out); err != nil {
+		return meta, fmt.Errorf("Call(%s %s): %w: %w", method, endpoint, ErrorFailedToParseResponse, err)
+	}
+
+	return meta, nil
+}
@@ -0,0 +1,105 @@
+package manifold
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+)
+
+// Candle is the probability movement and volume of a market during a single interval, in OHLC form.
+type Candle struct {
+	Start  time.Time // Start of the interval
+	Open   float64   // Probability at the start of the interval
+	High   float64   // Highest probability during the interval
+	Low    float64   // Lowest probability during the interval
+	Close  float64   // Probability at the end of the interval
+	Volume float64   // Traded volume, counting sales as volume too
+	Bets   int       // Number of bets placed
+}
+
+// Candles converts the bet history of a binary market, or of a single answer of a multiple choice market, into OHLC
+// candles, the usual input of charting libraries. Intervals without bets are flat at the previous close.
+//
+// Parameters:
+//   - bets: The bet history of the market or answer, in any order. Must not be empty. Required.
+//   - start: The start of the first interval. Required.
+//   - end: The end of the series. Must be after start. Required.
+//   - interval: The length of each interval. Must be greater than zero. Required.
+//
+// Returns:
+//   - []Candle: The candle of every interval, in order.
+//   - error: An error object if input validation fails.
+func Candles(bets []Bet, start time.Time, end time.Time, interval time.Duration) ([]Candle, error) {
+	if !end.After(start) {
+		return nil, fmt.Errorf("Candles: end must be after start")
+	}
+
+	if interval <= 0 {
+		return nil, fmt.Errorf("Candles(interval): invalid value: %v, must be greater than 0", interval)
+	}
+
+	if len(bets) == 0 {
+		return nil, fmt.Errorf("Candles(bets): no bets to build candles from")
+	}
+
+	sorted := make([]Bet, len(bets))
+	copy(sorted, bets)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].CreatedTime < sorted[j].CreatedTime
+	})
+
+	// The probability before the first interval is that after the last earlier bet, or before the first bet.
+	startMs, endMs := start.UnixMilli(), end.UnixMilli()
+	prob := sorted[0].ProbBefore
+	i := 0
+	for ; i < len(sorted) && sorted[i].CreatedTime < startMs; i++ {
+		prob = sorted[i].ProbAfter
+	}
+
+	count := int((end.Sub(start) + interval - 1) / interval)
+	candles := make([]Candle, count)
+	for c := range candles {
+		candles[c] = Candle{Start: start.Add(time.Duration(c) * interval), Open: prob, High: prob, Low: prob, Close: prob}
+		intervalEnd := int64(math.Min(float64(candles[c].Start.Add(interval).UnixMilli()), float64(endMs)))
+
+		for ; i < len(sorted) && sorted[i].CreatedTime < intervalEnd; i++ {
+			bet := sorted[i]
+			candle := &candles[c]
+
+			candle.Bets++
+			if bet.Amount < 0 {
+				candle.Volume -= bet.Amount
+			} else {
+				candle.Volume += bet.Amount
+			}
+
+			prob = bet.ProbAfter
+			candle.High = math.Max(candle.High, prob)
+			candle.Low = math.Min(candle.Low, prob)
+			candle.Close = prob
+		}
+	}
+
+	return candles, nil
+}
+
+// Candles computes the OHLC candles of a tracked binary market from its stored bet history (see Candles).
+//
+// Parameters:
+//   - contractID: The ID of the market. Required.
+//   - start: The start of the first interval. Required.
+//   - end: The end of the series. Must be after start. Required.
+//   - interval: The length of each interval. Must be greater than zero. Required.
+//
+// Returns:
+//   - []Candle: The candle of every interval, in order.
+//   - error: An error object if the market has no stored bets or if input validation fails.
+func (h *BetHistory) Candles(contractID string, start time.Time, end time.Time, interval time.Duration) ([]Candle, error) {
+	candles, err := Candles(h.Bets(contractID), start, end, interval)
+	if err != nil {
+		return nil, fmt.Errorf("BetHistory: %w", err)
+	}
+
+	return candles, nil
+}
@@ -0,0 +1,55 @@
+package manifold
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestCandles(t *testing.T) {
+	start := time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+	at := func(d time.Duration) int64 { return start.Add(d).UnixMilli() }
+	bet := func(d time.Duration, amount float64, before float64, after float64) Bet {
+		return Bet{CreatedTime: at(d), Amount: amount, ProbBefore: before, ProbAfter: after}
+	}
+
+	bets := []Bet{
+		bet(90*time.Minute, 5, 0.55, 0.5), // Out of order, in the second interval
+		bet(-time.Hour, 10, 0.4, 0.45),    // Before the first interval
+		bet(30*time.Minute, 10, 0.45, 0.6),
+		bet(70*time.Minute, -20, 0.6, 0.55), // A sale counts as volume
+	}
+
+	tests := []struct {
+		name     string
+		bets     []Bet
+		end      time.Time
+		interval time.Duration
+		want     []Candle
+		wantErr  bool
+	}{
+		{"candles", bets, start.Add(3 * time.Hour), time.Hour, []Candle{
+			{Start: start, Open: 0.45, High: 0.6, Low: 0.45, Close: 0.6, Volume: 10, Bets: 1},
+			{Start: start.Add(time.Hour), Open: 0.6, High: 0.6, Low: 0.5, Close: 0.5, Volume: 25, Bets: 2},
+			{Start: start.Add(2 * time.Hour), Open: 0.5, High: 0.5, Low: 0.5, Close: 0.5},
+		}, false},
+		{"partial last interval", bets[2:3], start.Add(90 * time.Minute), time.Hour, []Candle{
+			{Start: start, Open: 0.45, High: 0.6, Low: 0.45, Close: 0.6, Volume: 10, Bets: 1},
+			{Start: start.Add(time.Hour), Open: 0.6, High: 0.6, Low: 0.6, Close: 0.6},
+		}, false},
+		{"end before start", bets, start, time.Hour, nil, true},
+		{"zero interval", bets, start.Add(time.Hour), 0, nil, true},
+		{"no bets", nil, start.Add(time.Hour), time.Hour, nil, true},
+	}
+
+	for _, tt := range tests {
+		candles, err := Candles(tt.bets, start, tt.end, tt.interval)
+		if (err != nil) != tt.wantErr {
+			t.Fatalf("%s: Candles() error = %v, want error %v", tt.name, err, tt.wantErr)
+		}
+
+		if !reflect.DeepEqual(candles, tt.want) {
+			t.Errorf("%s: Candles() = %+v, want %+v", tt.name, candles, tt.want)
+		}
+	}
+}
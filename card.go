@@ -0,0 +1,76 @@
+package manifold
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// cardDescriptionLength is the maximum length of MarketCard.Description, in characters.
+const cardDescriptionLength = 200
+
+// MarketCard is the data of a rich link preview (OpenGraph card) for a market.
+type MarketCard struct {
+	Title       string   // Question of the market
+	Description string   // Start of the market's description
+	URL         string   // Link to the market
+	ImageURL    *string  // Cover image of the market, or the creator's avatar if it has none (optional)
+	Probability *float64 // Current probability, for binary markets (optional)
+	Summary     string   // One-line summary of the market's state, e.g. "53% chance" or "Resolved YES"
+}
+
+// Card builds the preview card of a market from its data, for notifiers that render their own previews when the
+// platform fails to unfurl links.
+//
+// Returns:
+//   - MarketCard: The preview card of the market.
+func (m *FullMarket) Card() MarketCard {
+	card := MarketCard{
+		Title:       m.Question,
+		Description: strings.Join(strings.Fields(m.TextDescription), " "),
+		URL:         m.URL,
+		ImageURL:    m.CoverImageUrl,
+		Probability: m.Probability,
+	}
+
+	if card.ImageURL == nil {
+		card.ImageURL = m.CreatorAvatarURL
+	}
+
+	if utf8.RuneCountInString(card.Description) > cardDescriptionLength {
+		card.Description = string([]rune(card.Description)[:cardDescriptionLength-1]) + "…"
+	}
+
+	switch {
+	case m.IsResolved && m.Resolution != nil:
+		card.Summary = "Resolved " + *m.Resolution
+	case m.IsStonk() && m.Probability != nil:
+		card.Summary = fmt.Sprintf("Price %.2f", StonkPrice(*m.Probability))
+	case m.Probability != nil:
+		card.Summary = FormatProb(*m.Probability, 0) + " chance"
+	case m.Value != nil:
+		card.Summary = fmt.Sprintf("Expected value %.2f", *m.Value)
+	default:
+		card.Summary = fmt.Sprintf("%d traders", m.UniqueBettorCount)
+	}
+
+	return card
+}
+
+// Card retrieves a market and builds its preview card (see FullMarket.Card).
+//
+// Parameters:
+//   - id: The ID of the market. Required.
+//
+// Returns:
+//   - *MarketCard: A pointer to the preview card of the market.
+//   - error: An error object if the request fails or if the response cannot be parsed.
+func (s *MarketService) Card(id string) (*MarketCard, error) {
+	market, err := s.Market(id)
+	if err != nil {
+		return nil, fmt.Errorf("Market: Card: %w", err)
+	}
+
+	card := market.Card()
+	return &card, nil
+}
@@ -0,0 +1,243 @@
+// Package chart renders market probability histories and portfolio equity curves to PNG images, using only the
+// standard library, so notifiers can attach charts without a separate plotting service.
+package chart
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/e74000/manifold"
+)
+
+// Point is a single value of a series at a point in time.
+type Point struct {
+	Time  time.Time // Time of the value
+	Value float64   // The value
+}
+
+// Options controls how a chart is drawn.
+type Options struct {
+	Width      int         // Width of the image in pixels
+	Height     int         // Height of the image in pixels
+	Min        *float64    // Bottom of the value axis, or the smallest value if nil (optional)
+	Max        *float64    // Top of the value axis, or the largest value if nil (optional)
+	Step       bool        // Whether values hold until the next point, as for probabilities, instead of interpolating
+	GridLines  int         // Number of horizontal grid lines between the bottom and top
+	Background color.Color // Background color
+	Grid       color.Color // Grid line color
+	Line       color.Color // Series line color
+	Fill       color.Color // Color of the area under the line, or nil for no fill (optional)
+}
+
+// DefaultOptions are options for a 600x300 chart with a light background and a blue line.
+var DefaultOptions = Options{
+	Width:      600,
+	Height:     300,
+	GridLines:  3,
+	Background: color.RGBA{0xff, 0xff, 0xff, 0xff},
+	Grid:       color.RGBA{0xe5, 0xe7, 0xeb, 0xff},
+	Line:       color.RGBA{0x4f, 0x46, 0xe5, 0xff},
+	Fill:       color.NRGBA{0x4f, 0x46, 0xe5, 0x30},
+}
+
+// padding is the space left around the plot area, in pixels.
+const padding = 8
+
+// Render draws a series as a line chart and encodes it as PNG.
+//
+// Parameters:
+//   - points: The series to draw, in any order. Must have at least one point. Required.
+//   - opts: The options controlling the chart, e.g. DefaultOptions. Required.
+//
+// Returns:
+//   - []byte: The PNG image.
+//   - error: An error object if input validation fails or the image cannot be encoded.
+func Render(points []Point, opts Options) ([]byte, error) {
+	if len(points) == 0 {
+		return nil, fmt.Errorf("Render: no points to draw")
+	}
+
+	if opts.Width <= 2*padding || opts.Height <= 2*padding {
+		return nil, fmt.Errorf("Render: image must be larger than %dx%d", 2*padding, 2*padding)
+	}
+
+	sorted := make([]Point, len(points))
+	copy(sorted, points)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Time.Before(sorted[j].Time)
+	})
+
+	lo, hi := sorted[0].Value, sorted[0].Value
+	for _, p := range sorted {
+		lo, hi = math.Min(lo, p.Value), math.Max(hi, p.Value)
+	}
+	if opts.Min != nil {
+		lo = *opts.Min
+	}
+	if opts.Max != nil {
+		hi = *opts.Max
+	}
+	if hi <= lo {
+		lo, hi = lo-1, hi+1
+	}
+
+	img := image.NewRGBA(image.Rect(0, 0, opts.Width, opts.Height))
+	draw.Draw(img, img.Bounds(), &image.Uniform{opts.Background}, image.Point{}, draw.Src)
+
+	left, right := padding, opts.Width-padding-1
+	top, bottom := padding, opts.Height-padding-1
+
+	for i := 0; i <= opts.GridLines+1; i++ {
+		y := bottom - (bottom-top)*i/(opts.GridLines+1)
+		for x := left; x <= right; x++ {
+			img.Set(x, y, opts.Grid)
+		}
+	}
+
+	start, end := sorted[0].Time, sorted[len(sorted)-1].Time
+	span := end.Sub(start)
+	toX := func(t time.Time) int {
+		if span <= 0 {
+			return left
+		}
+		return left + int(math.Round(float64(t.Sub(start))/float64(span)*float64(right-left)))
+	}
+	toY := func(v float64) int {
+		v = math.Max(lo, math.Min(hi, v))
+		return bottom - int(math.Round((v-lo)/(hi-lo)*float64(bottom-top)))
+	}
+
+	// Trace the line as a y coordinate for every x, then fill under it and draw it.
+	ys := make([]int, right+1)
+	for x := range ys {
+		ys[x] = -1
+	}
+	if len(sorted) == 1 || span <= 0 {
+		for x := left; x <= right; x++ {
+			ys[x] = toY(sorted[len(sorted)-1].Value)
+		}
+	} else {
+		for i := 1; i < len(sorted); i++ {
+			x0, x1 := toX(sorted[i-1].Time), toX(sorted[i].Time)
+			y0, y1 := toY(sorted[i-1].Value), toY(sorted[i].Value)
+			for x := x0; x <= x1; x++ {
+				y := y0
+				if !opts.Step && x1 > x0 {
+					y = y0 + int(math.Round(float64((y1-y0)*(x-x0))/float64(x1-x0)))
+				}
+				ys[x] = y
+			}
+		}
+	}
+
+	if opts.Fill != nil {
+		for x := left; x <= right; x++ {
+			if ys[x] < 0 {
+				continue
+			}
+			for y := ys[x]; y <= bottom; y++ {
+				blend(img, x, y, opts.Fill)
+			}
+		}
+	}
+
+	prev := -1
+	for x := left; x <= right; x++ {
+		if ys[x] < 0 {
+			continue
+		}
+
+		// Join vertical jumps between neighbouring columns so steps and steep moves stay connected.
+		from, to := ys[x], ys[x]
+		if prev >= 0 {
+			from, to = min(prev, ys[x]), max(prev, ys[x])
+		}
+		for y := from; y <= to; y++ {
+			img.Set(x, y, opts.Line)
+			if y+1 <= bottom {
+				img.Set(x, y+1, opts.Line)
+			}
+		}
+		prev = ys[x]
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, fmt.Errorf("Render: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// blend draws a translucent color over a pixel.
+func blend(img *image.RGBA, x int, y int, c color.Color) {
+	r, g, b, a := c.RGBA()
+	br, bg, bb, ba := img.At(x, y).RGBA()
+	inv := 0xffff - a
+	img.Set(x, y, color.RGBA64{
+		R: uint16(r + br*inv/0xffff),
+		G: uint16(g + bg*inv/0xffff),
+		B: uint16(b + bb*inv/0xffff),
+		A: uint16(a + ba*inv/0xffff),
+	})
+}
+
+// ProbabilityHistory draws the probability history of a binary market from its bets, on a fixed 0 to 100% axis.
+//
+// Parameters:
+//   - bets: The bets of the market, in any order. Bets on answers and redemptions are ignored. Required.
+//   - opts: The options controlling the chart, e.g. DefaultOptions. Min, Max and Step are overridden. Required.
+//
+// Returns:
+//   - []byte: The PNG image.
+//   - error: An error object if there are no bets to draw or the image cannot be encoded.
+func ProbabilityHistory(bets []manifold.Bet, opts Options) ([]byte, error) {
+	var filtered []manifold.Bet
+	for _, bet := range bets {
+		if bet.AnswerID == nil && !bet.IsRedemption {
+			filtered = append(filtered, bet)
+		}
+	}
+
+	sort.SliceStable(filtered, func(i, j int) bool {
+		return filtered[i].CreatedTime < filtered[j].CreatedTime
+	})
+
+	points := make([]Point, 0, len(filtered)+1)
+	if len(filtered) > 0 {
+		points = append(points, Point{Time: time.UnixMilli(filtered[0].CreatedTime), Value: filtered[0].ProbBefore})
+	}
+	for _, bet := range filtered {
+		points = append(points, Point{Time: time.UnixMilli(bet.CreatedTime), Value: bet.ProbAfter})
+	}
+
+	lo, hi := 0.0, 1.0
+	opts.Min, opts.Max, opts.Step = &lo, &hi, true
+
+	return Render(points, opts)
+}
+
+// EquityCurve draws the value of a portfolio over time.
+//
+// Parameters:
+//   - portfolios: The portfolio reconstructed at several points in time, in any order. Required.
+//   - opts: The options controlling the chart, e.g. DefaultOptions. Required.
+//
+// Returns:
+//   - []byte: The PNG image.
+//   - error: An error object if there are no portfolios to draw or the image cannot be encoded.
+func EquityCurve(portfolios []*manifold.HistoricPortfolio, opts Options) ([]byte, error) {
+	points := make([]Point, 0, len(portfolios))
+	for _, portfolio := range portfolios {
+		points = append(points, Point{Time: portfolio.Time, Value: portfolio.Value})
+	}
+
+	return Render(points, opts)
+}
@@ -3,39 +3,98 @@ package manifold
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io/ioutil"
+	"log/slog"
 	"net/http"
+	"strconv"
 	"time"
 )
 
 // Client represents the Manifold API client, used to interact with various services such as users, groups, markets, and more.
-// It manages API requests and provides access to all the available services.
+// It manages API requests and provides access to all the available services. Configuration added to it must also be
+// copied by clone.
 type Client struct {
 	BaseURL    string       // The base URL for the Manifold API.
 	APIKey     string       // The API key used for authentication with the Manifold API.
 	HTTPClient *http.Client // The HTTP client used to perform requests.
 
+	Environment Environment // The Manifold environment the client talks to. Defaults to EnvironmentProd.
+
+	UserAgent string      // The User-Agent sent with every request. Defaults to DefaultUserAgent.
+	Headers   http.Header // Custom headers sent with every request. Optional.
+
+	ContentFilter ContentFilter                                   // Filter applied to outgoing comments and market text. Optional.
+	OnRateLimit   func(endpoint string, retryAfter time.Duration) // Called whenever a request is rejected for rate limiting. Optional.
+
+	RateLimitRetries int           // Number of times a rate limited request is retried after waiting. Defaults to 0.
+	RateLimitMaxWait time.Duration // Longest wait before a retry; requests asked to wait longer fail instead. Defaults to 0.
+
+	ClockSkewThreshold time.Duration            // Skew of the local clock beyond which OnClockSkew is called and Now corrects for it. Defaults to 2 seconds.
+	OnClockSkew        func(skew time.Duration) // Called whenever the estimated clock skew goes beyond ClockSkewThreshold. Optional.
+
+	OnResponse func(meta *ResponseMeta) // Called with the metadata of every response received. Optional.
+
 	User    *UserService    // Service for user-related API calls.
 	Group   *GroupService   // Service for group-related API calls.
 	Market  *MarketService  // Service for market-related API calls.
 	Bet     *BetService     // Service for bet-related API calls.
 	Comment *CommentService // Service for comment-related API calls.
 	Mana    *ManaService    // Service for mana-related API calls.
+
+	clock   clockSkew
+	breaker *circuitBreaker
+	logger  *slog.Logger
+	metrics Metrics
+
+	responseMeta responseMetaState
+}
+
+// Option configures a Client when passed to NewClient.
+type Option func(*Client)
+
+// defaultRateLimitWait is how long a rate limited request waits before a retry if the API does not say, capped at
+// RateLimitMaxWait.
+const defaultRateLimitWait = time.Second
+
+// WithRateLimitRetry makes the client wait and retry requests rejected for rate limiting, instead of returning
+// a *RateLimitError straight away.
+//
+// Parameters:
+//   - retries: The number of times a request is retried. Required.
+//   - maxWait: The longest wait before a retry. Requests asked to wait longer return a *RateLimitError. Required.
+//
+// Returns:
+//   - Option: An option to pass to NewClient.
+func WithRateLimitRetry(retries int, maxWait time.Duration) Option {
+	return func(c *Client) {
+		c.RateLimitRetries = retries
+		c.RateLimitMaxWait = maxWait
+	}
 }
 
 // NewClient creates a new instance of the Manifold API client.
 //
 // Parameters:
 //   - apiKey: The API key used for authenticating with the Manifold API.
+//   - opts: Options applied to the client in order. Optional.
 //
 // Returns:
 //   - *Client: A pointer to the newly created Client instance, pre-configured with services.
-func NewClient(apiKey string) *Client {
+func NewClient(apiKey string, opts ...Option) *Client {
 	c := &Client{
-		BaseURL:    "https://api.manifold.markets/v0",
-		APIKey:     apiKey,
-		HTTPClient: &http.Client{Timeout: 10 * time.Second},
+		BaseURL:     EnvironmentProd.BaseURL,
+		APIKey:      apiKey,
+		HTTPClient:  &http.Client{Timeout: 10 * time.Second},
+		Environment: EnvironmentProd,
+		UserAgent:   DefaultUserAgent,
+
+		ClockSkewThreshold: defaultClockSkewThreshold,
+	}
+
+	for _, opt := range opts {
+		opt(c)
 	}
 
 	// Initialize all services associated with the client.
@@ -49,6 +108,34 @@ func NewClient(apiKey string) *Client {
 	return c
 }
 
+// clone creates a client with the same configuration, including the settings of options and services. The circuit
+// breaker is shared, as both clients talk to the same API, while the clock skew estimate and response metadata are not.
+func (c *Client) clone() *Client {
+	clone := NewClient(c.APIKey)
+	clone.BaseURL = c.BaseURL
+	clone.HTTPClient = c.HTTPClient
+	clone.Environment = c.Environment
+	clone.UserAgent = c.UserAgent
+	clone.Headers = c.Headers.Clone()
+	clone.ContentFilter = c.ContentFilter
+	clone.OnRateLimit = c.OnRateLimit
+	clone.RateLimitRetries = c.RateLimitRetries
+	clone.RateLimitMaxWait = c.RateLimitMaxWait
+	clone.ClockSkewThreshold = c.ClockSkewThreshold
+	clone.OnClockSkew = c.OnClockSkew
+	clone.OnResponse = c.OnResponse
+
+	clone.breaker = c.breaker
+	clone.logger = c.logger
+	clone.metrics = c.metrics
+
+	clone.Bet.paper = c.Bet.paper
+	clone.Bet.closeGuard = c.Bet.closeGuard
+	clone.Comment.guard = c.Comment.guard
+
+	return clone
+}
+
 // GET performs a GET request to the Manifold API.
 //
 // Parameters:
@@ -57,7 +144,7 @@ func NewClient(apiKey string) *Client {
 //
 // Returns:
 //   - []byte: The response body as a byte slice.
-//   - error: An error object if the request fails or if the response cannot be read.
+//   - error: An error object if the request fails, if the API responds with a non-2xx status (*APIError), or if the response cannot be read.
 func (c *Client) GET(endpoint string, params map[string]string) ([]byte, error) {
 	req, err := http.NewRequest("GET", fmt.Sprintf("%s%s", c.BaseURL, endpoint), nil)
 	if err != nil {
@@ -76,13 +163,8 @@ func (c *Client) GET(endpoint string, params map[string]string) ([]byte, error)
 		req.Header.Add("Authorization", fmt.Sprintf("Key %s", c.APIKey))
 	}
 
-	resp, err := c.HTTPClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	return ioutil.ReadAll(resp.Body)
+	body, _, err := c.do(endpoint, params, req)
+	return body, err
 }
 
 // POST performs a POST request to the Manifold API.
@@ -93,7 +175,7 @@ func (c *Client) GET(endpoint string, params map[string]string) ([]byte, error)
 //
 // Returns:
 //   - []byte: The response body as a byte slice.
-//   - error: An error object if the request fails or if the response cannot be read.
+//   - error: An error object if the request fails, if the API responds with a non-2xx status (*APIError), or if the response cannot be read.
 func (c *Client) POST(endpoint string, body interface{}) ([]byte, error) {
 	var (
 		jsonBody []byte
@@ -120,11 +202,155 @@ func (c *Client) POST(endpoint string, body interface{}) ([]byte, error) {
 		req.Header.Add("Authorization", fmt.Sprintf("Key %s", c.APIKey))
 	}
 
+	result, _, err := c.do(endpoint, bodyParams(jsonBody), req)
+	return result, err
+}
+
+// do performs a request, retrying it after rate limit rejections up to RateLimitRetries times, and failing fast
+// while the circuit breaker is open. The User-Agent and custom headers of the client are added first. It returns
+// the metadata of the last response, or nil if none was received.
+func (c *Client) do(endpoint string, params map[string]string, req *http.Request) ([]byte, *ResponseMeta, error) {
+	c.setDefaultHeaders(req)
+
+	for attempt := 0; ; attempt++ {
+		if c.breaker != nil {
+			if err := c.breaker.allow(time.Now()); err != nil {
+				c.logRequest(req.Method, endpoint, 0, 0, attempt, err)
+				c.observeRequest(req.Method, endpoint, 0, 0, attempt, err)
+				return nil, nil, err
+			}
+		}
+
+		start := time.Now()
+		body, meta, err := c.send(endpoint, params, req)
+		status := 0
+		if meta != nil {
+			status = meta.StatusCode
+		}
+		if c.breaker != nil {
+			c.breaker.record(err, time.Now())
+		}
+		latency := time.Since(start)
+		c.logRequest(req.Method, endpoint, status, latency, attempt, err)
+		c.observeRequest(req.Method, endpoint, status, latency, attempt, err)
+
+		var rateLimited *RateLimitError
+		if !errors.As(err, &rateLimited) || attempt >= c.RateLimitRetries || rateLimited.RetryAfter > c.RateLimitMaxWait {
+			return body, meta, err
+		}
+
+		wait := rateLimited.RetryAfter
+		if wait == 0 {
+			wait = min(defaultRateLimitWait, c.RateLimitMaxWait)
+		}
+		time.Sleep(wait)
+
+		// The body of the previous attempt has been consumed, so it is rewound for the retry.
+		if req.GetBody != nil {
+			if req.Body, err = req.GetBody(); err != nil {
+				return nil, meta, err
+			}
+		}
+	}
+}
+
+// send performs a request once, reporting rate limit rejections through OnRateLimit and a *RateLimitError, and
+// other non-2xx responses as an *APIError. The Date header of every response is used to estimate the skew of the
+// local clock, and the metadata of every response is recorded and returned.
+func (c *Client) send(endpoint string, params map[string]string, req *http.Request) ([]byte, *ResponseMeta, error) {
+	start := time.Now()
 	resp, err := c.HTTPClient.Do(req)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	defer resp.Body.Close()
 
-	return ioutil.ReadAll(resp.Body)
+	now := time.Now()
+	c.recordClockSkew(resp.Header.Get("Date"), start, now)
+	meta := c.recordResponse(endpoint, resp, now)
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		rateLimited := &RateLimitError{
+			RetryAfter: parseRetryAfter(resp.Header.Get("Retry-After"), now),
+			Reset:      meta.RateLimitReset,
+		}
+		rateLimited.Limit, _ = strconv.Atoi(resp.Header.Get("X-RateLimit-Limit"))
+
+		if rateLimited.RetryAfter == 0 && rateLimited.Reset.After(now) {
+			rateLimited.RetryAfter = rateLimited.Reset.Sub(now)
+		}
+
+		if c.OnRateLimit != nil {
+			c.OnRateLimit(endpoint, rateLimited.RetryAfter)
+		}
+
+		return nil, meta, rateLimited
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, meta, err
+	}
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		apiErr := newAPIError(resp.StatusCode, endpoint, params, body)
+		apiErr.RequestID = meta.RequestID
+		return nil, meta, apiErr
+	}
+
+	return body, meta, nil
+}
+
+// bodyParams flattens the top-level fields of a JSON object body into strings, to report in an APIError.
+func bodyParams(jsonBody []byte) map[string]string {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(jsonBody, &fields); err != nil {
+		return nil
+	}
+
+	params := make(map[string]string, len(fields))
+	for key, value := range fields {
+		var s string
+		if err := json.Unmarshal(value, &s); err == nil {
+			params[key] = s
+		} else {
+			params[key] = string(value)
+		}
+	}
+
+	return params
+}
+
+// parseRetryAfter parses the value of a Retry-After header, given either in seconds or as an HTTP date.
+// It returns 0 if the header is missing or invalid.
+func parseRetryAfter(header string, now time.Time) time.Duration {
+	if header == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(header); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second
+	}
+
+	if at, err := http.ParseTime(header); err == nil && at.After(now) {
+		return at.Sub(now)
+	}
+
+	return 0
+}
+
+// parseRateLimitReset parses the value of an X-RateLimit-Reset header, given either as a Unix timestamp in seconds
+// or as a number of seconds from now. It returns the zero time if the header is missing or invalid.
+func parseRateLimitReset(header string, now time.Time) time.Time {
+	value, err := strconv.ParseInt(header, 10, 64)
+	if err != nil || value < 0 {
+		return time.Time{}
+	}
+
+	// Values too large to be a delay are timestamps.
+	if value > 1e9 {
+		return time.Unix(value, 0)
+	}
+
+	return now.Add(time.Duration(value) * time.Second)
 }
@@ -0,0 +1,36 @@
+package manifold
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestRateLimitRetryWaitIsCapped(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests == 1 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Write([]byte(`{"id":"u1"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("key", WithRateLimitRetry(1, 50*time.Millisecond))
+	client.BaseURL = server.URL
+
+	start := time.Now()
+	if _, err := client.User.Me(); err != nil {
+		t.Fatalf("Me() error = %v", err)
+	}
+
+	if elapsed := time.Since(start); elapsed >= defaultRateLimitWait {
+		t.Errorf("retry waited %v, want at most the 50ms RateLimitMaxWait", elapsed)
+	}
+	if requests != 2 {
+		t.Errorf("made %d requests, want 2", requests)
+	}
+}
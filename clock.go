@@ -0,0 +1,93 @@
+package manifold
+
+import (
+	"net/http"
+	"sync"
+	"time"
+)
+
+// defaultClockSkewThreshold is the default ClockSkewThreshold. The Date header only has a resolution of one
+// second, so smaller skews cannot be measured reliably.
+const defaultClockSkewThreshold = 2 * time.Second
+
+// clockSkew estimates the offset of the server clock from the local clock.
+type clockSkew struct {
+	mu       sync.Mutex
+	skew     time.Duration
+	measured bool
+	exceeded bool
+}
+
+// WithClockSkew sets the threshold beyond which the local clock is considered skewed, and a callback to warn
+// about it.
+//
+// Parameters:
+//   - threshold: The skew beyond which onSkew is called and Now corrects for the skew. Required.
+//   - onSkew: Called with the estimated skew whenever it goes beyond the threshold. Optional.
+//
+// Returns:
+//   - Option: An option to pass to NewClient.
+func WithClockSkew(threshold time.Duration, onSkew func(skew time.Duration)) Option {
+	return func(c *Client) {
+		c.ClockSkewThreshold = threshold
+		c.OnClockSkew = onSkew
+	}
+}
+
+// ClockSkew returns the estimated offset of the server clock from the local clock, measured from the Date header
+// of responses. A positive skew means the local clock is behind the server.
+//
+// Returns:
+//   - time.Duration: The estimated skew, or 0 if no response with a Date header has been received yet.
+func (c *Client) ClockSkew() time.Duration {
+	c.clock.mu.Lock()
+	defer c.clock.mu.Unlock()
+
+	return c.clock.skew
+}
+
+// Now returns the current time, corrected for the skew of the local clock if it is beyond ClockSkewThreshold.
+// It is used for deadline checks such as limit order expiry and market close times, and should be used to
+// compute deadlines sent to the API.
+//
+// Returns:
+//   - time.Time: The current time as the server sees it.
+func (c *Client) Now() time.Time {
+	skew := c.ClockSkew()
+	if skew > c.ClockSkewThreshold || -skew > c.ClockSkewThreshold {
+		return time.Now().Add(skew)
+	}
+
+	return time.Now()
+}
+
+// recordClockSkew updates the skew estimate from the Date header of a response received between start and end,
+// and calls OnClockSkew if the estimate goes beyond ClockSkewThreshold.
+func (c *Client) recordClockSkew(header string, start time.Time, end time.Time) {
+	date, err := http.ParseTime(header)
+	if err != nil {
+		return
+	}
+
+	// The Date header is truncated to the second, so its midpoint is compared to the middle of the round trip.
+	sample := date.Add(500 * time.Millisecond).Sub(start.Add(end.Sub(start) / 2))
+
+	c.clock.mu.Lock()
+	if c.clock.measured {
+		// Smooth out the jitter from the header's resolution and varying latency.
+		c.clock.skew = (4*c.clock.skew + sample) / 5
+	} else {
+		c.clock.skew = sample
+		c.clock.measured = true
+	}
+
+	skew := c.clock.skew
+	exceeded := skew > c.ClockSkewThreshold || -skew > c.ClockSkewThreshold
+	report := exceeded && !c.clock.exceeded
+	c.clock.exceeded = exceeded
+	c.clock.mu.Unlock()
+
+	if report && c.OnClockSkew != nil {
+		c.OnClockSkew(skew)
+	}
+}
@@ -0,0 +1,98 @@
+package manifold
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// closeGuardRefresh is how long a CloseGuard caches the close time of a market.
+const closeGuardRefresh = time.Minute
+
+// CloseWarning reports a limit order whose expiration falls after the close of its market, so it will stop
+// filling when the market closes rather than when it expires.
+type CloseWarning struct {
+	ContractID string    // ID of the market
+	CloseTime  time.Time // Close time of the market
+	ExpiresAt  time.Time // Expiration time of the order
+}
+
+// CloseGuard blocks market orders placed through a BetService shortly before their market closes, when they are
+// likely to be rejected or wasted, and warns about limit orders that outlive their market.
+type CloseGuard struct {
+	buffer    time.Duration
+	onWarning func(CloseWarning)
+
+	mu    sync.Mutex
+	cache map[string]closeTimeEntry
+}
+
+// closeTimeEntry is a close time cached by a CloseGuard.
+type closeTimeEntry struct {
+	closeTime *int64
+	fetched   time.Time
+}
+
+// NewCloseGuard creates a new close guard.
+//
+// Parameters:
+//   - buffer: How long before a market's close market orders are blocked. Required.
+//   - onWarning: Called when a limit order expires after its market closes. Optional.
+//
+// Returns:
+//   - *CloseGuard: A pointer to the newly created close guard.
+func NewCloseGuard(buffer time.Duration, onWarning func(CloseWarning)) *CloseGuard {
+	return &CloseGuard{
+		buffer:    buffer,
+		onWarning: onWarning,
+		cache:     make(map[string]closeTimeEntry),
+	}
+}
+
+// SetCloseGuard sets the guard that checks bets placed through the service against their market's close time.
+//
+// Parameters:
+//   - guard: The guard to apply to every bet. If nil, bets are no longer checked.
+func (s *BetService) SetCloseGuard(guard *CloseGuard) {
+	s.closeGuard = guard
+}
+
+// check blocks a market order placed within the buffer of its market's close, and warns if a limit order
+// expires after the close. Close times are cached for a minute.
+func (g *CloseGuard) check(client *Client, contractID string, limitProb *float64, expiresAt *time.Time) error {
+	now := client.Now()
+
+	g.mu.Lock()
+	entry, ok := g.cache[contractID]
+	g.mu.Unlock()
+
+	if !ok || now.Sub(entry.fetched) > closeGuardRefresh {
+		market, err := client.Market.Market(contractID)
+		if err != nil {
+			return err
+		}
+
+		entry = closeTimeEntry{closeTime: market.CloseTime, fetched: now}
+		g.mu.Lock()
+		g.cache[contractID] = entry
+		g.mu.Unlock()
+	}
+
+	if entry.closeTime == nil {
+		return nil
+	}
+	closeTime := time.UnixMilli(*entry.closeTime)
+
+	if limitProb == nil {
+		if remaining := closeTime.Sub(now); remaining < g.buffer {
+			return fmt.Errorf("%w: market closes in %v", ErrorMarketClosing, remaining.Round(time.Second))
+		}
+		return nil
+	}
+
+	if expiresAt != nil && expiresAt.After(closeTime) && g.onWarning != nil {
+		g.onWarning(CloseWarning{ContractID: contractID, CloseTime: closeTime, ExpiresAt: *expiresAt})
+	}
+
+	return nil
+}
@@ -0,0 +1,112 @@
+package manifold
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// CommentGuard limits the rate of comments posted through a CommentService and suppresses duplicate content,
+// protecting accounts from buggy bots that would otherwise spam markets.
+type CommentGuard struct {
+	perMarket       int
+	global          int
+	window          time.Duration
+	duplicateWindow time.Duration
+
+	mu      sync.Mutex
+	history []commentRecord
+}
+
+// commentRecord is a comment previously allowed by a CommentGuard.
+type commentRecord struct {
+	contractID string
+	hash       [sha256.Size]byte
+	time       time.Time
+}
+
+// NewCommentGuard creates a new comment guard.
+//
+// Parameters:
+//   - perMarket: The maximum number of comments on a single market within the window. 0 disables the limit.
+//   - global: The maximum number of comments across all markets within the window. 0 disables the limit.
+//   - window: The sliding window the rate limits apply to. Required if either limit is set.
+//   - duplicateWindow: How long identical content on the same market is suppressed for. 0 disables suppression.
+//
+// Returns:
+//   - *CommentGuard: A pointer to the newly created comment guard.
+func NewCommentGuard(perMarket int, global int, window time.Duration, duplicateWindow time.Duration) *CommentGuard {
+	return &CommentGuard{
+		perMarket:       perMarket,
+		global:          global,
+		window:          window,
+		duplicateWindow: duplicateWindow,
+	}
+}
+
+// allow checks whether a comment may be posted now and, if so, records it. The record is returned so it can be
+// forgotten if the comment then fails to post.
+func (g *CommentGuard) allow(contractID string, content string) (commentRecord, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	now := time.Now()
+	hash := sha256.Sum256([]byte(content))
+
+	keep := g.window
+	if g.duplicateWindow > keep {
+		keep = g.duplicateWindow
+	}
+
+	history := g.history[:0]
+	for _, record := range g.history {
+		if now.Sub(record.time) < keep {
+			history = append(history, record)
+		}
+	}
+	g.history = history
+
+	var inMarket, inGlobal int
+	for _, record := range g.history {
+		age := now.Sub(record.time)
+
+		if g.duplicateWindow > 0 && age < g.duplicateWindow && record.contractID == contractID && record.hash == hash {
+			return commentRecord{}, fmt.Errorf("%w: identical comment posted %v ago", ErrorDuplicateComment, age.Round(time.Second))
+		}
+
+		if age < g.window {
+			inGlobal++
+			if record.contractID == contractID {
+				inMarket++
+			}
+		}
+	}
+
+	if g.perMarket > 0 && inMarket >= g.perMarket {
+		return commentRecord{}, fmt.Errorf("%w: %d comments on market %s within %v", ErrorCommentRateLimited, inMarket, contractID, g.window)
+	}
+
+	if g.global > 0 && inGlobal >= g.global {
+		return commentRecord{}, fmt.Errorf("%w: %d comments within %v", ErrorCommentRateLimited, inGlobal, g.window)
+	}
+
+	record := commentRecord{contractID: contractID, hash: hash, time: now}
+	g.history = append(g.history, record)
+
+	return record, nil
+}
+
+// forget removes a record returned by allow, for a comment that failed to post, so that retrying it is neither
+// suppressed as a duplicate nor counted against the rate limits.
+func (g *CommentGuard) forget(record commentRecord) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	for i := range g.history {
+		if g.history[i] == record {
+			g.history = append(g.history[:i], g.history[i+1:]...)
+			return
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package manifold
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestCommentGuardAllow(t *testing.T) {
+	tests := []struct {
+		name     string
+		guard    *CommentGuard
+		comments [][2]string
+		want     []error
+	}{
+		{
+			name:     "duplicate on the same market",
+			guard:    NewCommentGuard(0, 0, 0, time.Hour),
+			comments: [][2]string{{"a", "hi"}, {"b", "hi"}, {"a", "hi"}, {"a", "bye"}},
+			want:     []error{nil, nil, ErrorDuplicateComment, nil},
+		},
+		{
+			name:     "per-market limit",
+			guard:    NewCommentGuard(2, 0, time.Hour, 0),
+			comments: [][2]string{{"a", "1"}, {"a", "2"}, {"a", "3"}, {"b", "4"}},
+			want:     []error{nil, nil, ErrorCommentRateLimited, nil},
+		},
+		{
+			name:     "global limit",
+			guard:    NewCommentGuard(0, 2, time.Hour, 0),
+			comments: [][2]string{{"a", "1"}, {"b", "2"}, {"c", "3"}},
+			want:     []error{nil, nil, ErrorCommentRateLimited},
+		},
+		{
+			name:     "rejected comments are not counted",
+			guard:    NewCommentGuard(0, 2, time.Hour, time.Hour),
+			comments: [][2]string{{"a", "1"}, {"a", "1"}, {"a", "2"}},
+			want:     []error{nil, ErrorDuplicateComment, nil},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for i, comment := range tt.comments {
+				_, err := tt.guard.allow(comment[0], comment[1])
+				if !errors.Is(err, tt.want[i]) || (err == nil) != (tt.want[i] == nil) {
+					t.Errorf("comment %d: allow() error = %v, want %v", i, err, tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestCommentGuardForget(t *testing.T) {
+	guard := NewCommentGuard(1, 0, time.Hour, time.Hour)
+
+	record, err := guard.allow("a", "hi")
+	if err != nil {
+		t.Fatalf("allow() error = %v", err)
+	}
+	guard.forget(record)
+
+	if _, err := guard.allow("a", "hi"); err != nil {
+		t.Errorf("allow() after forget error = %v, want nil", err)
+	}
+}
+
+func TestCommentRetryAfterFailedPost(t *testing.T) {
+	fail := true
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if fail {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("key")
+	client.BaseURL = server.URL
+	client.Comment.SetGuard(NewCommentGuard(0, 0, 0, time.Hour))
+
+	if err := client.Comment.CommentMarkdown("a", "hi"); err == nil {
+		t.Fatal("CommentMarkdown() error = nil, want a POST failure")
+	}
+
+	fail = false
+	if err := client.Comment.CommentMarkdown("a", "hi"); err != nil {
+		t.Errorf("CommentMarkdown() retry error = %v, want nil", err)
+	}
+	if err := client.Comment.CommentMarkdown("a", "hi"); !errors.Is(err, ErrorDuplicateComment) {
+		t.Errorf("CommentMarkdown() duplicate error = %v, want %v", err, ErrorDuplicateComment)
+	}
+}
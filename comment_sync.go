@@ -0,0 +1,214 @@
+package manifold
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Since retrieves the comments on a contract posted after a point in time. Pages are fetched newest first with a
+// creation time cursor rather than an offset from the newest comment, so comments posted while paging neither shift
+// later pages nor cause comments to be skipped or returned twice.
+//
+// Parameters:
+//   - contractID: The ID of the contract. Required.
+//   - since: Only return comments posted after this time. Required.
+//
+// Returns:
+//   - []Comment: The comments posted after the time, oldest first.
+//   - error: An error object if a request fails or if the response cannot be parsed.
+func (s *CommentService) Since(contractID string, since time.Time) ([]Comment, error) {
+	var (
+		comments   []Comment
+		seen       = make(map[string]bool)
+		limit      = 1000
+		beforeTime *time.Time
+		offset     = 0
+		sinceMs    = since.UnixMilli()
+	)
+
+	for {
+		page, err := s.commentsPage(contractID, limit, offset, beforeTime, since)
+		if err != nil {
+			return nil, fmt.Errorf("Comment: Since: %w", err)
+		}
+
+		reached, added := false, false
+		oldest := int64(0)
+		for i, comment := range page {
+			if i == 0 || comment.CreatedTime < oldest {
+				oldest = comment.CreatedTime
+			}
+			if comment.CreatedTime <= sinceMs {
+				reached = true
+				continue
+			}
+			if seen[comment.ID] {
+				continue
+			}
+			seen[comment.ID] = true
+			added = true
+			comments = append(comments, comment)
+		}
+
+		if reached || !added || len(page) < limit {
+			break
+		}
+
+		// The next page ends just after the oldest comment, skipping the comments at that creation time that have
+		// already been fetched. No comment can be posted in the past, so the offset within it is stable.
+		next := time.UnixMilli(oldest + 1)
+		if beforeTime != nil && next.Equal(*beforeTime) {
+			offset += len(page)
+		} else {
+			offset = 0
+			for _, comment := range page {
+				if comment.CreatedTime == oldest {
+					offset++
+				}
+			}
+		}
+		beforeTime = &next
+	}
+
+	sort.SliceStable(comments, func(i, j int) bool {
+		return comments[i].CreatedTime < comments[j].CreatedTime
+	})
+
+	return comments, nil
+}
+
+// commentsPage retrieves the newest comments on a contract posted after afterTime and, if set, before beforeTime,
+// skipping the first offset of them.
+func (s *CommentService) commentsPage(contractID string, limit int, offset int, beforeTime *time.Time, afterTime time.Time) ([]Comment, error) {
+	params := map[string]string{
+		"contractId": contractID,
+		"limit":      fmt.Sprintf("%d", limit),
+		"offset":     fmt.Sprintf("%d", offset),
+		"afterTime":  fmt.Sprintf("%d", afterTime.UnixMilli()),
+	}
+
+	if beforeTime != nil {
+		params["beforeTime"] = fmt.Sprintf("%d", beforeTime.UnixMilli())
+	}
+
+	return GetJSON[[]Comment](s.client, "/comments", params)
+}
+
+// CommentCursor is the position of a CommentSyncer in the comments of a market. It can be stored by the caller
+// and passed back to CommentSyncer.Track to resume syncing after a restart without reprocessing old comments.
+type CommentCursor struct {
+	Time int64    `json:"time"` // Creation time of the newest comment seen, in milliseconds
+	IDs  []string `json:"ids"`  // IDs of the comments seen with that creation time
+}
+
+// CommentSyncer fetches only the comments posted on tracked markets since the previous sync.
+type CommentSyncer struct {
+	client *Client
+
+	mu      sync.Mutex
+	cursors map[string]CommentCursor
+}
+
+// NewCommentSyncer creates a new comment syncer.
+//
+// Parameters:
+//   - client: The client used to fetch comments. Required.
+//
+// Returns:
+//   - *CommentSyncer: A pointer to the newly created syncer, with no markets tracked.
+func NewCommentSyncer(client *Client) *CommentSyncer {
+	return &CommentSyncer{
+		client:  client,
+		cursors: make(map[string]CommentCursor),
+	}
+}
+
+// Track starts tracking a market from a cursor. Only comments posted after the cursor are returned by Sync.
+//
+// Parameters:
+//   - contractID: The ID of the market to track. Required.
+//   - cursor: The cursor to resume from. A zero value syncs every comment, and a cursor with only a time skips older comments. Required.
+func (s *CommentSyncer) Track(contractID string, cursor CommentCursor) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.cursors[contractID] = cursor
+}
+
+// Untrack stops tracking a market.
+//
+// Parameters:
+//   - contractID: The ID of the market to stop tracking. Required.
+func (s *CommentSyncer) Untrack(contractID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.cursors, contractID)
+}
+
+// Cursor returns the current cursor of a tracked market, for persisting.
+//
+// Parameters:
+//   - contractID: The ID of the market. Required.
+//
+// Returns:
+//   - CommentCursor: The cursor of the market.
+//   - bool: Whether the market is tracked.
+func (s *CommentSyncer) Cursor(contractID string) (CommentCursor, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	cursor, ok := s.cursors[contractID]
+	return cursor, ok
+}
+
+// Sync fetches the comments posted on a tracked market since the previous sync and advances its cursor.
+//
+// Parameters:
+//   - contractID: The ID of the market. Required.
+//
+// Returns:
+//   - []Comment: The new comments, oldest first.
+//   - error: An error object if the market is not tracked or if a request fails.
+func (s *CommentSyncer) Sync(contractID string) ([]Comment, error) {
+	cursor, ok := s.Cursor(contractID)
+	if !ok {
+		return nil, fmt.Errorf("CommentSyncer: Sync: market %s is not tracked", contractID)
+	}
+
+	// Comments with the same creation time as the cursor may not all have been seen yet.
+	comments, err := s.client.Comment.Since(contractID, time.UnixMilli(cursor.Time-1))
+	if err != nil {
+		return nil, fmt.Errorf("CommentSyncer: Sync: %w", err)
+	}
+
+	seen := make(map[string]bool, len(cursor.IDs))
+	for _, id := range cursor.IDs {
+		seen[id] = true
+	}
+
+	fresh := make([]Comment, 0, len(comments))
+	for _, comment := range comments {
+		if comment.CreatedTime == cursor.Time && seen[comment.ID] {
+			continue
+		}
+		fresh = append(fresh, comment)
+
+		if comment.CreatedTime > cursor.Time {
+			cursor = CommentCursor{Time: comment.CreatedTime}
+		}
+		if comment.CreatedTime == cursor.Time {
+			cursor.IDs = append(cursor.IDs, comment.ID)
+		}
+	}
+
+	s.mu.Lock()
+	if _, ok := s.cursors[contractID]; ok {
+		s.cursors[contractID] = cursor
+	}
+	s.mu.Unlock()
+
+	return fresh, nil
+}
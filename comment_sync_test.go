@@ -0,0 +1,110 @@
+package manifold
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestCommentsSincePagesByTime(t *testing.T) {
+	tests := []struct {
+		name     string
+		total    int
+		perMs    int
+		sinceMs  int64
+		newPosts int
+	}{
+		{name: "single page", total: 10, perMs: 1, sinceMs: 0},
+		{name: "several pages", total: 2500, perMs: 3, sinceMs: 0},
+		{name: "since cuts the history", total: 2500, perMs: 3, sinceMs: 300},
+		{name: "comments posted while paging", total: 2500, perMs: 3, sinceMs: 0, newPosts: 50},
+		{name: "page shares one timestamp", total: 2500, perMs: 1200, sinceMs: 0},
+		{name: "pages share one timestamp", total: 2500, perMs: 2500, sinceMs: 0, newPosts: 50},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var mu sync.Mutex
+			var comments []Comment
+			for i := 0; i < tt.total; i++ {
+				comments = append(comments, Comment{ID: fmt.Sprintf("c%d", i), CreatedTime: 1 + int64(i/tt.perMs)})
+			}
+			original := len(comments)
+
+			requests := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				mu.Lock()
+				defer mu.Unlock()
+
+				requests++
+				if requests == 2 {
+					// New comments are the newest, so offset paging would shift every later page.
+					for i := 0; i < tt.newPosts; i++ {
+						comments = append(comments, Comment{ID: fmt.Sprintf("new%d", i), CreatedTime: 1e6})
+					}
+				}
+
+				query := r.URL.Query()
+				offset, _ := strconv.Atoi(query.Get("offset"))
+				limit, _ := strconv.Atoi(query.Get("limit"))
+				after, _ := strconv.ParseInt(query.Get("afterTime"), 10, 64)
+				before := int64(1 << 62)
+				if value := query.Get("beforeTime"); value != "" {
+					before, _ = strconv.ParseInt(value, 10, 64)
+				}
+
+				var page []Comment
+				for i := len(comments) - 1; i >= 0 && len(page) < limit; i-- {
+					if comments[i].CreatedTime <= after || comments[i].CreatedTime >= before {
+						continue
+					}
+					if offset > 0 {
+						offset--
+						continue
+					}
+					page = append(page, comments[i])
+				}
+				json.NewEncoder(w).Encode(page)
+			}))
+			defer server.Close()
+
+			client := NewClient("key")
+			client.BaseURL = server.URL
+
+			got, err := client.Comment.Since("m", time.UnixMilli(tt.sinceMs))
+			if err != nil {
+				t.Fatalf("Since() error = %v", err)
+			}
+
+			want := 0
+			for _, comment := range comments[:original] {
+				if comment.CreatedTime > tt.sinceMs {
+					want++
+				}
+			}
+
+			ids := make(map[string]bool, len(got))
+			for _, comment := range got {
+				if ids[comment.ID] {
+					t.Fatalf("comment %s returned twice", comment.ID)
+				}
+				ids[comment.ID] = true
+				if comment.CreatedTime <= tt.sinceMs {
+					t.Errorf("comment %s at %d is not after %d", comment.ID, comment.CreatedTime, tt.sinceMs)
+				}
+			}
+			if len(got) != want {
+				t.Errorf("Since() returned %d comments, want %d", len(got), want)
+			}
+			if !sort.SliceIsSorted(got, func(i, j int) bool { return got[i].CreatedTime < got[j].CreatedTime }) {
+				t.Error("Since() comments are not oldest first")
+			}
+		})
+	}
+}
@@ -0,0 +1,182 @@
+package manifold
+
+import (
+	"fmt"
+	"time"
+)
+
+// Strategy is a single step of a trading strategy. It is given a client whose bets are recorded in the
+// strategy's own paper book, while reads are live.
+type Strategy func(client *Client) error
+
+// StrategyReport is the paper performance of one strategy in a StrategyComparison.
+type StrategyReport struct {
+	Name     string  // Name of the strategy
+	Orders   int     // Number of orders placed
+	Invested float64 // Total amount spent on fills
+	Value    float64 // Value of the positions held, marked at current probabilities
+	Profit   float64 // Value - Invested
+	Halted   bool    // Whether the strategy stopped trading after reaching its budget
+}
+
+// StrategyComparison runs two strategies side by side in paper trading mode on the same live markets, each with
+// its own client, paper book and budget, so their performance can be compared without one affecting the other.
+type StrategyComparison struct {
+	client *Client
+	budget float64
+	arms   []*strategyArm
+}
+
+// strategyArm is one of the strategies run by a StrategyComparison.
+type strategyArm struct {
+	name     string
+	strategy Strategy
+	client   *Client
+	book     *PaperBook
+}
+
+// NewStrategyComparison creates a new comparison of two strategies.
+//
+// Parameters:
+//   - client: The client whose configuration the strategies' clients are copied from. Required.
+//   - nameA: The name of the first strategy. Required.
+//   - a: The first strategy. Required.
+//   - nameB: The name of the second strategy. Required.
+//   - b: The second strategy. Required.
+//   - budget: The amount each strategy may invest. Orders beyond it are rejected with ErrorPaperBudget, and a strategy
+//     that has invested all of it stops being stepped. Must be greater than 0. Required.
+//
+// Returns:
+//   - *StrategyComparison: A pointer to the newly created comparison.
+//   - error: An error object if input validation fails.
+func NewStrategyComparison(client *Client, nameA string, a Strategy, nameB string, b Strategy, budget float64) (*StrategyComparison, error) {
+	if budget <= 0 {
+		return nil, fmt.Errorf("NewStrategyComparison(budget): invalid value: %v, must be greater than 0", budget)
+	}
+
+	c := &StrategyComparison{client: client, budget: budget}
+	for _, arm := range []struct {
+		name     string
+		strategy Strategy
+	}{{nameA, a}, {nameB, b}} {
+		book := NewPaperBook()
+		book.SetBudget(budget)
+		c.arms = append(c.arms, &strategyArm{
+			name:     arm.name,
+			strategy: arm.strategy,
+			client:   paperClient(client, book),
+			book:     book,
+		})
+	}
+
+	return c, nil
+}
+
+// paperClient creates a copy of a client whose bets are recorded in a paper book.
+func paperClient(client *Client, book *PaperBook) *Client {
+	clone := client.clone()
+	clone.Bet.SetPaper(book)
+
+	return clone
+}
+
+// Step runs one step of each strategy that has not reached its budget.
+//
+// Returns:
+//   - error: An error object if a strategy fails. The other strategy is still stepped.
+func (c *StrategyComparison) Step() error {
+	var firstErr error
+	for _, arm := range c.arms {
+		if paperInvested(arm.book.Orders()) >= c.budget {
+			continue
+		}
+
+		if err := arm.strategy(arm.client); err != nil && firstErr == nil {
+			firstErr = fmt.Errorf("StrategyComparison: Step(%s): %w", arm.name, err)
+		}
+	}
+
+	return firstErr
+}
+
+// paperInvested returns the total amount spent on the fills of orders.
+func paperInvested(orders []Bet) float64 {
+	var total float64
+	for _, order := range orders {
+		total += order.Amount
+	}
+
+	return total
+}
+
+// Report marks the positions of both strategies at the current probabilities of their markets.
+//
+// Returns:
+//   - []StrategyReport: The report of each strategy, in the order they were given.
+//   - error: An error object if a market cannot be retrieved.
+func (c *StrategyComparison) Report() ([]StrategyReport, error) {
+	markets := make(map[string]*FullMarket)
+	reports := make([]StrategyReport, 0, len(c.arms))
+
+	for _, arm := range c.arms {
+		orders := arm.book.Orders()
+		report := StrategyReport{Name: arm.name, Orders: len(orders), Invested: paperInvested(orders)}
+		report.Halted = report.Invested >= c.budget
+
+		for _, order := range orders {
+			market, ok := markets[order.ContractID]
+			if !ok {
+				var err error
+				market, err = c.client.Market.Market(order.ContractID)
+				if err != nil {
+					return nil, fmt.Errorf("StrategyComparison: Report: %w", err)
+				}
+				markets[order.ContractID] = market
+			}
+
+			prob := valueOr(market.Probability, 0)
+			if order.AnswerID != nil && market.Answers != nil {
+				for _, answer := range *market.Answers {
+					if answer.ID == *order.AnswerID {
+						prob = answer.Probability
+					}
+				}
+			}
+
+			switch order.Outcome {
+			case "YES":
+				report.Value += order.Shares * prob
+			case "NO":
+				report.Value += order.Shares * (1 - prob)
+			}
+		}
+
+		report.Profit = report.Value - report.Invested
+		reports = append(reports, report)
+	}
+
+	return reports, nil
+}
+
+// Run calls Step at the given interval until stop is closed. Errors from Step are passed to onError.
+//
+// Parameters:
+//   - interval: The time between steps. Required.
+//   - stop: A channel that stops the comparison when closed. Required.
+//   - onError: Called with every error returned by Step. Optional.
+func (c *StrategyComparison) Run(interval time.Duration, stop <-chan struct{}, onError func(error)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := c.Step(); err != nil && onError != nil {
+			onError(err)
+		}
+
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
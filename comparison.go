@@ -0,0 +1,133 @@
+package manifold
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// UserComparison summarizes the trading of a single user over a period, for comparing users head to head.
+type UserComparison struct {
+	UserID   string             // ID of the user
+	Profit   float64            // Result of the markets the user bet on that resolved within the period
+	Volume   float64            // Total absolute amount traded within the period
+	Bets     int                // Number of bets placed within the period
+	Resolved int                // Number of bets placed within the period on binary markets that have resolved YES or NO
+	HitRate  float64            // Fraction of resolved bets whose outcome was the resolution
+	Edge     float64            // Average of the resolved bets' hit (1 or 0) minus the price paid; positive if the user beat the market
+	Topics   map[string]float64 // Profit per group slug of the resolved markets
+}
+
+// CompareUsers compares the trading of several users over [start, end) from their public bets.
+//
+// Parameters:
+//   - bets: The bets placed by each user, keyed by user ID. Required.
+//   - markets: The markets the bets were placed in, keyed by market ID. Required.
+//   - start: Only count activity from this time onwards. Required.
+//   - end: Only count activity before this time. Required.
+//
+// Returns:
+//   - []UserComparison: The comparison of every user, ordered by profit, highest first.
+func CompareUsers(bets map[string][]Bet, markets map[string]*FullMarket, start time.Time, end time.Time) []UserComparison {
+	startMs, endMs := start.UnixMilli(), end.UnixMilli()
+	comparisons := make([]UserComparison, 0, len(bets))
+
+	for userID, userBets := range bets {
+		comparison := UserComparison{UserID: userID, Topics: make(map[string]float64)}
+
+		var hits int
+		var edge float64
+		for _, bet := range userBets {
+			if bet.CreatedTime < startMs || bet.CreatedTime >= endMs || bet.IsRedemption {
+				continue
+			}
+
+			comparison.Bets++
+			if bet.Amount < 0 {
+				comparison.Volume -= bet.Amount
+			} else {
+				comparison.Volume += bet.Amount
+			}
+
+			market, ok := markets[bet.ContractID]
+			if !ok || market.OutcomeType != "BINARY" || market.Resolution == nil || bet.Amount <= 0 || bet.Shares <= 0 {
+				continue
+			}
+			if *market.Resolution != "YES" && *market.Resolution != "NO" {
+				continue
+			}
+
+			comparison.Resolved++
+			hit := 0.0
+			if bet.Outcome == *market.Resolution {
+				hits++
+				hit = 1
+			}
+			edge += hit - bet.Amount/bet.Shares
+		}
+
+		if comparison.Resolved > 0 {
+			comparison.HitRate = float64(hits) / float64(comparison.Resolved)
+			comparison.Edge = edge / float64(comparison.Resolved)
+		}
+
+		for _, line := range resolvedResults(userBets, markets, startMs, endMs, time.UTC) {
+			comparison.Profit += line.Result
+
+			if groups := markets[line.ContractID].GroupSlugs; groups != nil {
+				for _, slug := range *groups {
+					comparison.Topics[slug] += line.Result
+				}
+			}
+		}
+
+		comparisons = append(comparisons, comparison)
+	}
+
+	sort.Slice(comparisons, func(i, j int) bool {
+		if comparisons[i].Profit != comparisons[j].Profit {
+			return comparisons[i].Profit > comparisons[j].Profit
+		}
+		return comparisons[i].UserID < comparisons[j].UserID
+	})
+
+	return comparisons
+}
+
+// Compare fetches the bets of several users and the markets they bet on, and compares their trading over
+// [start, end) (see CompareUsers).
+//
+// Parameters:
+//   - userIDs: The IDs of the users to compare. Required.
+//   - start: Only count activity from this time onwards. Required.
+//   - end: Only count activity before this time. Required.
+//
+// Returns:
+//   - []UserComparison: The comparison of every user, ordered by profit, highest first.
+//   - error: An error object if a request fails or if the response cannot be parsed.
+func (s *UserService) Compare(userIDs []string, start time.Time, end time.Time) ([]UserComparison, error) {
+	bets := make(map[string][]Bet, len(userIDs))
+	markets := make(map[string]*FullMarket)
+
+	for _, userID := range userIDs {
+		userBets, err := s.client.Bet.allBets(&userID, nil, nil)
+		if err != nil {
+			return nil, fmt.Errorf("User: Compare: %w", err)
+		}
+		bets[userID] = userBets
+
+		for _, bet := range userBets {
+			if _, ok := markets[bet.ContractID]; ok {
+				continue
+			}
+
+			market, err := s.client.Market.Market(bet.ContractID)
+			if err != nil {
+				return nil, fmt.Errorf("User: Compare: %w", err)
+			}
+			markets[bet.ContractID] = market
+		}
+	}
+
+	return CompareUsers(bets, markets, start, end), nil
+}
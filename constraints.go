@@ -0,0 +1,165 @@
+package manifold
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// ConstraintViolation reports a constraint that the current probabilities break.
+type ConstraintViolation struct {
+	Constraint string   // Description of the constraint
+	IDs        []string // IDs of the markets or answers involved in the violation
+	Magnitude  float64  // How far the probabilities are from satisfying the constraint, beyond the tolerance
+}
+
+// Constraint is a relationship that the probabilities of a set of markets or answers should satisfy.
+type Constraint interface {
+	// IDs returns the IDs of the markets or answers the constraint is over.
+	IDs() []string
+	// Violations checks the constraint against probabilities keyed by market or answer ID.
+	Violations(probs map[string]float64) []ConstraintViolation
+}
+
+// SumConstraint requires the probabilities of a set of mutually exclusive outcomes to add up to a target, usually 1.
+type SumConstraint struct {
+	Members   []string // IDs of the markets or answers
+	Target    float64  // Expected sum
+	Tolerance float64  // Allowed absolute deviation from the target
+}
+
+// IDs returns the IDs of the members.
+func (c SumConstraint) IDs() []string {
+	return c.Members
+}
+
+// Violations reports the deviation of the sum from the target, if it exceeds the tolerance.
+func (c SumConstraint) Violations(probs map[string]float64) []ConstraintViolation {
+	var sum float64
+	for _, id := range c.Members {
+		sum += probs[id]
+	}
+
+	if deviation := math.Abs(sum - c.Target); deviation > c.Tolerance {
+		return []ConstraintViolation{{
+			Constraint: fmt.Sprintf("sum = %.2f (is %.4f)", c.Target, sum),
+			IDs:        c.Members,
+			Magnitude:  deviation - c.Tolerance,
+		}}
+	}
+
+	return nil
+}
+
+// OrderConstraint requires one probability to be at least another, e.g. because one outcome implies the other.
+type OrderConstraint struct {
+	Greater   string  // ID of the market or answer expected to have the higher probability
+	Lesser    string  // ID of the market or answer expected to have the lower probability
+	Tolerance float64 // Allowed amount by which Lesser may exceed Greater
+}
+
+// IDs returns the IDs of both sides.
+func (c OrderConstraint) IDs() []string {
+	return []string{c.Greater, c.Lesser}
+}
+
+// Violations reports the amount by which Lesser exceeds Greater, if it exceeds the tolerance.
+func (c OrderConstraint) Violations(probs map[string]float64) []ConstraintViolation {
+	if excess := probs[c.Lesser] - probs[c.Greater]; excess > c.Tolerance {
+		return []ConstraintViolation{{
+			Constraint: fmt.Sprintf("%s >= %s", c.Greater, c.Lesser),
+			IDs:        []string{c.Greater, c.Lesser},
+			Magnitude:  excess - c.Tolerance,
+		}}
+	}
+
+	return nil
+}
+
+// MonotoneConstraint requires probabilities to be non-decreasing in order, such as "by March", "by June", and
+// "by December" markets on the same event.
+type MonotoneConstraint struct {
+	Members   []string // IDs of the markets or answers, in order of expected increasing probability
+	Tolerance float64  // Allowed decrease between consecutive members
+}
+
+// IDs returns the IDs of the members.
+func (c MonotoneConstraint) IDs() []string {
+	return c.Members
+}
+
+// Violations reports every consecutive pair whose probability decreases by more than the tolerance.
+func (c MonotoneConstraint) Violations(probs map[string]float64) []ConstraintViolation {
+	var violations []ConstraintViolation
+	for i := 1; i < len(c.Members); i++ {
+		previous, current := c.Members[i-1], c.Members[i]
+		violations = append(violations, OrderConstraint{Greater: current, Lesser: previous, Tolerance: c.Tolerance}.Violations(probs)...)
+	}
+
+	return violations
+}
+
+// CheckConstraints checks a set of constraints against probabilities.
+//
+// Parameters:
+//   - constraints: The constraints to check. Required.
+//   - probs: The probabilities, keyed by market ID for binary markets and by answer ID for answers. Required.
+//
+// Returns:
+//   - []ConstraintViolation: Every violation, largest first.
+//   - error: An error object if the probability of a market or answer in a constraint is missing.
+func CheckConstraints(constraints []Constraint, probs map[string]float64) ([]ConstraintViolation, error) {
+	var violations []ConstraintViolation
+	for _, constraint := range constraints {
+		for _, id := range constraint.IDs() {
+			if _, ok := probs[id]; !ok {
+				return nil, fmt.Errorf("CheckConstraints: missing probability for %s", id)
+			}
+		}
+
+		violations = append(violations, constraint.Violations(probs)...)
+	}
+
+	sort.SliceStable(violations, func(i, j int) bool {
+		return violations[i].Magnitude > violations[j].Magnitude
+	})
+
+	return violations, nil
+}
+
+// CheckConstraints fetches the current probabilities of a set of markets and checks constraints against them
+// (see CheckConstraints). Constraints may reference the markets by ID, or their answers by answer ID.
+//
+// Parameters:
+//   - marketIDs: The IDs of the markets to fetch. Required.
+//   - constraints: The constraints to check. Required.
+//
+// Returns:
+//   - []ConstraintViolation: Every violation, largest first.
+//   - error: An error object if a request fails or if a probability in a constraint is missing.
+func (s *MarketService) CheckConstraints(marketIDs []string, constraints []Constraint) ([]ConstraintViolation, error) {
+	probs := make(map[string]float64)
+	for _, id := range marketIDs {
+		market, err := s.Market(id)
+		if err != nil {
+			return nil, fmt.Errorf("Market: CheckConstraints: %w", err)
+		}
+
+		if market.Probability != nil {
+			probs[market.ID] = *market.Probability
+		}
+
+		if market.Answers != nil {
+			for _, answer := range *market.Answers {
+				probs[answer.ID] = answer.Probability
+			}
+		}
+	}
+
+	violations, err := CheckConstraints(constraints, probs)
+	if err != nil {
+		return nil, fmt.Errorf("Market: %w", err)
+	}
+
+	return violations, nil
+}
@@ -0,0 +1,56 @@
+package manifold
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// ContentFilter checks outgoing user-visible text before it is posted, such as comments and market questions.
+// It returns the text to post, which may be rewritten, or an error to block the request entirely.
+type ContentFilter func(text string) (string, error)
+
+// WordlistFilter creates a content filter that matches the given words case-insensitively on word boundaries.
+//
+// Parameters:
+//   - words: The words to filter. Required.
+//   - rewrite: If true, matched words are masked with asterisks; otherwise, text containing them is blocked.
+//
+// Returns:
+//   - ContentFilter: The content filter. Blocked text returns an error wrapping ErrorContentBlocked.
+func WordlistFilter(words []string, rewrite bool) ContentFilter {
+	if len(words) == 0 {
+		return func(text string) (string, error) {
+			return text, nil
+		}
+	}
+
+	quoted := make([]string, len(words))
+	for i, word := range words {
+		quoted[i] = regexp.QuoteMeta(word)
+	}
+	pattern := regexp.MustCompile(`(?i)\b(` + strings.Join(quoted, "|") + `)\b`)
+
+	return func(text string) (string, error) {
+		if !rewrite {
+			if match := pattern.FindString(text); match != "" {
+				return "", fmt.Errorf("%w: contains %q", ErrorContentBlocked, match)
+			}
+
+			return text, nil
+		}
+
+		return pattern.ReplaceAllStringFunc(text, func(match string) string {
+			return strings.Repeat("*", len([]rune(match)))
+		}), nil
+	}
+}
+
+// filterContent applies the client's content filter, if any, to the given text.
+func (c *Client) filterContent(text string) (string, error) {
+	if c.ContentFilter == nil {
+		return text, nil
+	}
+
+	return c.ContentFilter(text)
+}
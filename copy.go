@@ -0,0 +1,258 @@
+package manifold
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// minBetAmount is the smallest amount the API accepts for a bet.
+const minBetAmount = 1.0
+
+// CopiedTrade records a bet of the copied user and the bet placed to mirror it, if any.
+type CopiedTrade struct {
+	Source *Bet   // Bet placed by the copied user
+	Copy   *Bet   // Bet placed to mirror it, or nil if it was skipped
+	Reason string // Why the bet was skipped, if it was
+}
+
+// TrackingReport compares the bets placed by a CopyTrader against those of the copied user.
+type TrackingReport struct {
+	Copied       int     // Number of bets mirrored
+	Skipped      int     // Number of bets skipped
+	SourceAmount float64 // Total amount of the mirrored bets of the copied user
+	CopiedAmount float64 // Total amount spent mirroring them
+	SourcePrice  float64 // Average price per share paid by the copied user on the mirrored bets
+	CopiedPrice  float64 // Average price per share paid when mirroring them
+	PriceError   float64 // CopiedPrice - SourcePrice; positive if mirroring paid more per share
+}
+
+// CopyTrader mirrors the public market orders of another user at a fraction of their size, with per-market caps,
+// a latency budget, and a list of excluded markets. Only buys on binary markets are mirrored, as sales and
+// multiple choice answers cannot be mapped to an equivalent order reliably. Limit orders are mirrored as market
+// orders for the amount filled when they are first seen.
+type CopyTrader struct {
+	client     *Client
+	targetID   string
+	fraction   float64
+	marketCap  float64
+	maxLatency time.Duration
+	exclude    map[string]bool
+
+	mu     sync.Mutex
+	primed bool
+	after  *string
+	spent  map[string]float64
+	trades []CopiedTrade
+}
+
+// NewCopyTrader creates a new copy trader. Bets placed by the copied user before the first Check are not mirrored.
+//
+// Parameters:
+//   - client: The client used to place mirrored bets. Required.
+//   - targetID: The ID of the user to copy. Required.
+//   - fraction: The fraction of each bet's amount to mirror. Must be greater than zero. Required.
+//   - marketCap: The maximum total amount to spend mirroring bets in a single market. 0 disables the cap.
+//   - maxLatency: The maximum age of a bet when it is seen for it to be mirrored. 0 disables the limit.
+//   - exclude: The IDs of markets never to mirror bets in. Optional.
+//
+// Returns:
+//   - *CopyTrader: A pointer to the newly created copy trader.
+//   - error: An error object if input validation fails.
+func NewCopyTrader(client *Client, targetID string, fraction float64, marketCap float64, maxLatency time.Duration, exclude []string) (*CopyTrader, error) {
+	if fraction <= 0 {
+		return nil, fmt.Errorf("NewCopyTrader(fraction): invalid value: %f, must be greater than 0", fraction)
+	}
+
+	excluded := make(map[string]bool, len(exclude))
+	for _, id := range exclude {
+		excluded[id] = true
+	}
+
+	return &CopyTrader{
+		client:     client,
+		targetID:   targetID,
+		fraction:   fraction,
+		marketCap:  marketCap,
+		maxLatency: maxLatency,
+		exclude:    excluded,
+		spent:      make(map[string]float64),
+	}, nil
+}
+
+// Trades returns every bet of the copied user seen so far and how it was handled, oldest first.
+func (t *CopyTrader) Trades() []CopiedTrade {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	trades := make([]CopiedTrade, len(t.trades))
+	copy(trades, t.trades)
+
+	return trades
+}
+
+// Report compares the mirrored bets against those of the copied user.
+func (t *CopyTrader) Report() TrackingReport {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	var (
+		report                     TrackingReport
+		sourceShares, copiedShares float64
+	)
+	for _, trade := range t.trades {
+		if trade.Copy == nil {
+			report.Skipped++
+			continue
+		}
+
+		report.Copied++
+		report.SourceAmount += trade.Source.Amount
+		report.CopiedAmount += trade.Copy.Amount
+		sourceShares += trade.Source.Shares
+		copiedShares += trade.Copy.Shares
+	}
+
+	if sourceShares > 0 && copiedShares > 0 {
+		report.SourcePrice = report.SourceAmount / sourceShares
+		report.CopiedPrice = report.CopiedAmount / copiedShares
+		report.PriceError = report.CopiedPrice - report.SourcePrice
+	}
+
+	return report
+}
+
+// Check fetches the bets placed by the copied user since the previous check and mirrors them.
+//
+// Returns:
+//   - error: An error object if a request fails. Bets the API rejects are skipped, while bets after any other failure
+//     are retried on the next check.
+func (t *CopyTrader) Check() error {
+	t.mu.Lock()
+	after, primed := t.after, t.primed
+	t.mu.Unlock()
+
+	var (
+		bets  []Bet
+		limit = 1000
+		order = "asc"
+	)
+	if !primed {
+		// Only the most recent bet is needed to start after it.
+		limit, order = 1, "desc"
+	}
+
+	for {
+		page, err := t.client.Bet.Bets(&t.targetID, nil, nil, nil, &limit, nil, after, nil, nil, nil, &order)
+		if err != nil {
+			return fmt.Errorf("CopyTrader: Check: %w", err)
+		}
+
+		bets = append(bets, page...)
+		if !primed || len(page) < limit {
+			break
+		}
+		after = &page[len(page)-1].ID
+	}
+
+	if !primed {
+		t.mu.Lock()
+		t.primed = true
+		if len(bets) > 0 {
+			t.after = &bets[0].ID
+		}
+		t.mu.Unlock()
+
+		return nil
+	}
+
+	for i := range bets {
+		if err := t.mirror(&bets[i]); err != nil {
+			return fmt.Errorf("CopyTrader: Check: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// mirror mirrors a single bet of the copied user, or records why it was skipped.
+func (t *CopyTrader) mirror(source *Bet) error {
+	trade := CopiedTrade{Source: source}
+	amount := source.Amount * t.fraction
+
+	t.mu.Lock()
+	spent := t.spent[source.ContractID]
+	t.mu.Unlock()
+
+	switch {
+	case source.IsRedemption || source.Amount <= 0:
+		trade.Reason = "not a buy"
+	case source.AnswerID != nil || (source.Outcome != "YES" && source.Outcome != "NO"):
+		trade.Reason = "not a binary market"
+	case source.LimitProps != nil && source.Shares == 0:
+		trade.Reason = "unfilled limit order"
+	case t.exclude[source.ContractID]:
+		trade.Reason = "excluded market"
+	case t.maxLatency > 0 && time.Since(time.UnixMilli(source.CreatedTime)) > t.maxLatency:
+		trade.Reason = "latency budget exceeded"
+	case t.marketCap > 0 && spent >= t.marketCap:
+		trade.Reason = "market cap reached"
+	}
+
+	if trade.Reason == "" && t.marketCap > 0 && spent+amount > t.marketCap {
+		amount = t.marketCap - spent
+	}
+	if trade.Reason == "" && amount < minBetAmount {
+		trade.Reason = "below minimum bet"
+	}
+
+	if trade.Reason == "" {
+		bet, err := t.client.Bet.Create(amount, source.ContractID, &source.Outcome, nil, nil, nil)
+
+		// Bets the API rejects outright, e.g. on a market that has since closed, would be rejected again on every
+		// retry and block the bets after them, so they are skipped. Other failures are retried on the next check.
+		var apiErr *APIError
+		if errors.As(err, &apiErr) && apiErr.StatusCode < http.StatusInternalServerError {
+			trade.Reason = fmt.Sprintf("rejected: %s", apiErr.Message)
+		} else if err != nil {
+			return err
+		}
+		trade.Copy = bet
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.after = &source.ID
+	t.trades = append(t.trades, trade)
+	if trade.Copy != nil {
+		t.spent[source.ContractID] += trade.Copy.Amount
+	}
+
+	return nil
+}
+
+// Run calls Check at the given interval until stop is closed. Errors from Check are passed to onError.
+//
+// Parameters:
+//   - interval: The time between checks. Required.
+//   - stop: A channel that stops the copy trader when closed. Required.
+//   - onError: Called with every error returned by Check. Optional.
+func (t *CopyTrader) Run(interval time.Duration, stop <-chan struct{}, onError func(error)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := t.Check(); err != nil && onError != nil {
+			onError(err)
+		}
+
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package manifold
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestCopyTraderCheck(t *testing.T) {
+	now := time.Now().UnixMilli()
+	var placed []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/bets":
+			var bets []Bet
+			switch {
+			case r.URL.Query().Get("order") == "desc":
+				bets = []Bet{{ID: "b0", CreatedTime: now}}
+			case r.URL.Query().Get("after") == "b0":
+				bets = []Bet{
+					{ID: "b1", ContractID: "small", Outcome: "YES", Amount: 1, Shares: 2, CreatedTime: now},
+					{ID: "b2", ContractID: "closed", Outcome: "YES", Amount: 10, Shares: 20, CreatedTime: now},
+					{ID: "b3", ContractID: "open", Outcome: "NO", Amount: 10, Shares: 20, CreatedTime: now},
+				}
+			}
+			json.NewEncoder(w).Encode(bets)
+		case "/bet":
+			var body map[string]string
+			decodeBody(t, r, &body)
+			if body["contractId"] == "closed" {
+				w.WriteHeader(http.StatusForbidden)
+				w.Write([]byte(`{"message":"Trading is closed."}`))
+				return
+			}
+			placed = append(placed, body["contractId"])
+			w.Write([]byte(`{"id":"copy","contractId":"` + body["contractId"] + `","amount":5,"shares":10}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("key")
+	client.BaseURL = server.URL
+
+	trader, err := NewCopyTrader(client, "target", 0.5, 0, 0, nil)
+	if err != nil {
+		t.Fatalf("NewCopyTrader() error = %v", err)
+	}
+
+	// The first check only finds the most recent bet to start after.
+	if err := trader.Check(); err != nil {
+		t.Fatalf("Check() error = %v", err)
+	}
+	if err := trader.Check(); err != nil {
+		t.Fatalf("Check() error = %v", err)
+	}
+
+	trades := trader.Trades()
+	want := []string{"below minimum bet", "rejected: Trading is closed.", ""}
+	if len(trades) != len(want) {
+		t.Fatalf("got %d trades, want %d", len(trades), len(want))
+	}
+	for i, trade := range trades {
+		if trade.Reason != want[i] {
+			t.Errorf("trade %d: Reason = %q, want %q", i, trade.Reason, want[i])
+		}
+	}
+	if len(placed) != 1 || placed[0] != "open" {
+		t.Errorf("placed = %v, want [open]", placed)
+	}
+	if trader.after == nil || *trader.after != "b3" {
+		t.Errorf("after = %v, want b3", trader.after)
+	}
+}
@@ -0,0 +1,126 @@
+package manifold
+
+import (
+	"fmt"
+	"math"
+)
+
+// cpmmProb returns the probability implied by a binary CPMM pool.
+func cpmmProb(yes float64, no float64, p float64) float64 {
+	return p * no / (p*no + (1-p)*yes)
+}
+
+// cpmmBuy simulates buying an outcome in a binary CPMM pool, ignoring fees and resting limit orders.
+// It returns the shares received and the pool after the purchase.
+func cpmmBuy(yes float64, no float64, p float64, outcome string, amount float64) (shares float64, newYes float64, newNo float64) {
+	// The pool keeps yes^p * no^(1-p) constant.
+	k := math.Pow(yes, p) * math.Pow(no, 1-p)
+
+	if outcome == "YES" {
+		newNo = no + amount
+		newYes = math.Pow(k/math.Pow(newNo, 1-p), 1/p)
+		return yes + amount - newYes, newYes, newNo
+	}
+
+	newYes = yes + amount
+	newNo = math.Pow(k/math.Pow(newYes, p), 1/(1-p))
+	return no + amount - newNo, newYes, newNo
+}
+
+// cpmmAmountToProb returns the amount of an outcome that must be bought from a binary CPMM pool to move it to the target probability.
+func cpmmAmountToProb(yes float64, no float64, p float64, outcome string, target float64) float64 {
+	// At the target probability, the pool satisfies newYes = c * newNo while keeping yes^p * no^(1-p) constant.
+	k := math.Pow(yes, p) * math.Pow(no, 1-p)
+	c := p * (1 - target) / ((1 - p) * target)
+	newNo := k / math.Pow(c, p)
+
+	if outcome == "YES" {
+		return newNo - no
+	}
+
+	return c*newNo - yes
+}
+
+// cpmmSell simulates selling shares of an outcome to a binary CPMM pool, ignoring fees and resting limit orders.
+// A sale buys the same number of shares of the other outcome and redeems the pairs, so it returns the amount received
+// for the shares and the pool after the sale.
+func cpmmSell(yes float64, no float64, p float64, outcome string, shares float64) (amount float64, newYes float64, newNo float64) {
+	other := "NO"
+	if outcome == "NO" {
+		other = "YES"
+	}
+
+	// The shares of the other outcome grow with the amount spent on them, and never cost more than one each.
+	low, high := 0.0, shares
+	for i := 0; i < 100; i++ {
+		mid := (low + high) / 2
+		if bought, _, _ := cpmmBuy(yes, no, p, other, mid); bought < shares {
+			low = mid
+		} else {
+			high = mid
+		}
+	}
+
+	_, newYes, newNo = cpmmBuy(yes, no, p, other, high)
+	return shares - high, newYes, newNo
+}
+
+// cpmmPool extracts the YES and NO pool and the p parameter of a binary CPMM market.
+func cpmmPool(market *LiteMarket) (yes float64, no float64, p float64, err error) {
+	if market.Mechanism != "cpmm-1" || market.P == nil {
+		return 0, 0, 0, fmt.Errorf("market %s is not a binary CPMM market", market.ID)
+	}
+
+	yes, okYes := market.Pool["YES"]
+	no, okNo := market.Pool["NO"]
+	if !okYes || !okNo || yes <= 0 || no <= 0 {
+		return 0, 0, 0, fmt.Errorf("market %s has no liquidity pool", market.ID)
+	}
+
+	return yes, no, *market.P, nil
+}
+
+// Quote is the estimated result of buying an outcome in a binary market.
+type Quote struct {
+	Amount       float64 // Amount spent
+	Shares       float64 // Shares received
+	AveragePrice float64 // Average price paid per share of the bought outcome
+	ProbBefore   float64 // Probability before the purchase
+	ProbAfter    float64 // Probability after the purchase
+	LimitShares  float64 // Shares matched against resting limit orders rather than the pool
+}
+
+// QuoteBuy estimates the result of buying an outcome in a binary CPMM market from its current pool, ignoring fees.
+//
+// Parameters:
+//   - market: The current state of the market. Required.
+//   - outcome: The outcome to buy ("YES" or "NO"). Required.
+//   - amount: The amount to spend. Must be greater than zero. Required.
+//
+// Returns:
+//   - *Quote: A pointer to the estimated result.
+//   - error: An error object if input validation fails or the market is not a binary CPMM market.
+func QuoteBuy(market *LiteMarket, outcome string, amount float64) (*Quote, error) {
+	if err := checkOneOf(outcome, "YES", "NO"); err != nil {
+		return nil, fmt.Errorf("QuoteBuy(outcome): %w", err)
+	}
+
+	if amount <= 0 {
+		return nil, fmt.Errorf("QuoteBuy(amount): invalid value: %f, value must be >0", amount)
+	}
+
+	yes, no, p, err := cpmmPool(market)
+	if err != nil {
+		return nil, fmt.Errorf("QuoteBuy: %w", err)
+	}
+
+	shares, newYes, newNo := cpmmBuy(yes, no, p, outcome, amount)
+
+	return &Quote{
+		Amount:       amount,
+		Shares:       shares,
+		AveragePrice: amount / shares,
+		ProbBefore:   cpmmProb(yes, no, p),
+		ProbAfter:    cpmmProb(newYes, newNo, p),
+	}, nil
+}
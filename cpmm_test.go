@@ -0,0 +1,60 @@
+package manifold
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCPMM(t *testing.T) {
+	tests := []struct {
+		name    string
+		yes, no float64
+		p       float64
+		outcome string
+		amount  float64
+	}{
+		{"even pool, buy YES", 100, 100, 0.5, "YES", 10},
+		{"even pool, buy NO", 100, 100, 0.5, "NO", 10},
+		{"skewed pool", 50, 200, 0.5, "YES", 25},
+		{"skewed p", 100, 100, 0.3, "NO", 40},
+	}
+
+	for _, tt := range tests {
+		before := cpmmProb(tt.yes, tt.no, tt.p)
+		shares, yes, no := cpmmBuy(tt.yes, tt.no, tt.p, tt.outcome, tt.amount)
+		after := cpmmProb(yes, no, tt.p)
+
+		// The pool invariant holds.
+		k := math.Pow(tt.yes, tt.p) * math.Pow(tt.no, 1-tt.p)
+		if got := math.Pow(yes, tt.p) * math.Pow(no, 1-tt.p); math.Abs(got-k) > 1e-9*k {
+			t.Errorf("%s: invariant %v, want %v", tt.name, got, k)
+		}
+
+		// Buying an outcome moves its probability towards it, at a price between the probabilities before and after.
+		price := tt.amount / shares
+		if tt.outcome == "YES" && !(after > before && price > before && price < after) {
+			t.Errorf("%s: prob %v -> %v at price %v", tt.name, before, after, price)
+		}
+		if tt.outcome == "NO" && !(after < before && price > 1-before && price < 1-after) {
+			t.Errorf("%s: prob %v -> %v at price %v", tt.name, before, after, price)
+		}
+
+		// Buying the amount to reach a probability reaches it.
+		if amount := cpmmAmountToProb(tt.yes, tt.no, tt.p, tt.outcome, after); math.Abs(amount-tt.amount) > 1e-9 {
+			t.Errorf("%s: cpmmAmountToProb() = %v, want %v", tt.name, amount, tt.amount)
+		}
+
+		// Selling the shares back restores the pool and returns the amount.
+		amount, yes, no := cpmmSell(yes, no, tt.p, tt.outcome, shares)
+		if math.Abs(amount-tt.amount) > 1e-6 || math.Abs(yes-tt.yes) > 1e-6 || math.Abs(no-tt.no) > 1e-6 {
+			t.Errorf("%s: cpmmSell() = %v, %v, %v, want %v, %v, %v", tt.name, amount, yes, no, tt.amount, tt.yes, tt.no)
+		}
+	}
+
+	if got := cpmmProb(100, 100, 0.5); got != 0.5 {
+		t.Errorf("cpmmProb(100, 100, 0.5) = %v, want 0.5", got)
+	}
+	if got := cpmmProb(100, 300, 0.5); got != 0.75 {
+		t.Errorf("cpmmProb(100, 300, 0.5) = %v, want 0.75", got)
+	}
+}
@@ -0,0 +1,85 @@
+package manifold
+
+import (
+	"sort"
+	"time"
+)
+
+// MarketRevenue is the income of a creator from a single market over a period.
+type MarketRevenue struct {
+	ContractID string  // ID of the market
+	Bettors    int     // Number of unique bettor bonuses received
+	Bonuses    float64 // Total unique bettor bonuses received
+	Boosts     float64 // Net amount of boost transactions, positive if received and negative if paid
+	Total      float64 // Bonuses + Boosts
+}
+
+// CreatorRevenue sums a creator's unique bettor bonuses and boost transactions per market within [start, end).
+// The API has no endpoint listing arbitrary transactions, so the transactions are supplied by the caller.
+//
+// Parameters:
+//   - txns: The transactions of the creator, in any order. Transactions of other categories are ignored. Required.
+//   - creatorID: The ID of the creator. Required.
+//   - start: Only count transactions from this time onwards. Required.
+//   - end: Only count transactions before this time. Required.
+//
+// Returns:
+//   - []MarketRevenue: The revenue of every market with transactions in the period, highest total first.
+func CreatorRevenue(txns []Txn, creatorID string, start time.Time, end time.Time) []MarketRevenue {
+	startMs, endMs := start.UnixMilli(), end.UnixMilli()
+	byContract := make(map[string]*MarketRevenue)
+	revenue := func(contractID string) *MarketRevenue {
+		r, ok := byContract[contractID]
+		if !ok {
+			r = &MarketRevenue{ContractID: contractID}
+			byContract[contractID] = r
+		}
+		return r
+	}
+
+	for i := range txns {
+		txn := &txns[i]
+		if txn.CreatedTime < startMs || txn.CreatedTime >= endMs {
+			continue
+		}
+
+		switch txn.TxnKind() {
+		case TxnUniqueBettorBonus:
+			data, err := txn.UniqueBettorBonus()
+			if err != nil || txn.ToID != creatorID {
+				continue
+			}
+
+			r := revenue(data.ContractID)
+			r.Bettors++
+			r.Bonuses += txn.Amount
+		case TxnMarketBoost:
+			data, err := txn.MarketBoost()
+			if err != nil {
+				continue
+			}
+
+			switch creatorID {
+			case txn.ToID:
+				revenue(data.ContractID).Boosts += txn.Amount
+			case txn.FromID:
+				revenue(data.ContractID).Boosts -= txn.Amount
+			}
+		}
+	}
+
+	revenues := make([]MarketRevenue, 0, len(byContract))
+	for _, r := range byContract {
+		r.Total = r.Bonuses + r.Boosts
+		revenues = append(revenues, *r)
+	}
+
+	sort.Slice(revenues, func(i, j int) bool {
+		if revenues[i].Total != revenues[j].Total {
+			return revenues[i].Total > revenues[j].Total
+		}
+		return revenues[i].ContractID < revenues[j].ContractID
+	})
+
+	return revenues
+}
@@ -0,0 +1,125 @@
+package manifold
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httputil"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// WithDebug makes the client write a dump of every request and response to a writer, to help report API
+// discrepancies. The API key is redacted from the dumps. It wraps the transport of the HTTP client set at the
+// time the option is applied, so it should come after any option replacing the HTTP client.
+//
+// Parameters:
+//   - w: The writer to write dumps to. Required.
+//   - curl: Whether to also write each request as a curl command, reading the API key from $MANIFOLD_API_KEY.
+//
+// Returns:
+//   - Option: An option to pass to NewClient.
+func WithDebug(w io.Writer, curl bool) Option {
+	return func(c *Client) {
+		base := c.HTTPClient.Transport
+		if base == nil {
+			base = http.DefaultTransport
+		}
+
+		httpClient := *c.HTTPClient
+		httpClient.Transport = &debugTransport{base: base, w: w, curl: curl, client: c}
+		c.HTTPClient = &httpClient
+	}
+}
+
+// debugTransport is an http.RoundTripper that dumps requests and responses.
+type debugTransport struct {
+	base   http.RoundTripper
+	w      io.Writer
+	curl   bool
+	client *Client
+
+	mu sync.Mutex
+}
+
+// RoundTrip dumps a request, sends it, and dumps its response.
+func (t *debugTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var b strings.Builder
+
+	if t.curl {
+		command, err := curlCommand(req)
+		if err != nil {
+			return nil, err
+		}
+		b.WriteString(command + "\n\n")
+	}
+
+	dump, err := httputil.DumpRequestOut(req, true)
+	if err != nil {
+		return nil, err
+	}
+	b.WriteString(t.client.redact(string(dump)) + "\n\n")
+
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		b.WriteString(fmt.Sprintf("error: %s\n\n", t.client.redact(err.Error())))
+	} else {
+		dump, dumpErr := httputil.DumpResponse(resp, true)
+		if dumpErr != nil {
+			resp.Body.Close()
+			return nil, dumpErr
+		}
+		b.WriteString(string(dump) + "\n\n")
+	}
+
+	t.mu.Lock()
+	io.WriteString(t.w, b.String())
+	t.mu.Unlock()
+
+	return resp, err
+}
+
+// curlCommand formats a request as a curl command, with the API key read from $MANIFOLD_API_KEY.
+func curlCommand(req *http.Request) (string, error) {
+	quote := func(s string) string {
+		return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+	}
+
+	parts := []string{"curl", "-X", req.Method, quote(req.URL.String())}
+
+	keys := make([]string, 0, len(req.Header))
+	for key := range req.Header {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		for _, value := range req.Header[key] {
+			if key == "Authorization" {
+				parts = append(parts, "-H", `"Authorization: Key $MANIFOLD_API_KEY"`)
+				continue
+			}
+			parts = append(parts, "-H", quote(key+": "+value))
+		}
+	}
+
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return "", err
+		}
+		defer body.Close()
+
+		data, err := io.ReadAll(body)
+		if err != nil {
+			return "", err
+		}
+
+		if len(data) > 0 {
+			parts = append(parts, "--data", quote(string(data)))
+		}
+	}
+
+	return strings.Join(parts, " "), nil
+}
@@ -0,0 +1,151 @@
+package manifold
+
+import (
+	"fmt"
+	"strings"
+)
+
+// defaultCommentLength is the comment length used by PostDigest when no limit is given, comfortably below the
+// maximum the API accepts.
+const defaultCommentLength = 8000
+
+// Table is structured data rendered as a Markdown table.
+type Table struct {
+	Title   string     // Heading rendered above the table (optional)
+	Headers []string   // Column headers
+	Rows    [][]string // Rows of cells, each with one cell per header
+}
+
+// Markdown renders the table as Markdown. Pipes and newlines in cells are escaped so they do not break the table.
+func (t Table) Markdown() string {
+	var b strings.Builder
+	if t.Title != "" {
+		b.WriteString("### " + t.Title + "\n\n")
+	}
+	b.WriteString(t.header())
+	for _, row := range t.Rows {
+		b.WriteString(tableRow(row))
+	}
+
+	return b.String()
+}
+
+// header renders the header and separator lines of the table.
+func (t Table) header() string {
+	separator := make([]string, len(t.Headers))
+	for i := range separator {
+		separator[i] = "---"
+	}
+
+	return tableRow(t.Headers) + tableRow(separator)
+}
+
+// tableRow renders a single row of a Markdown table.
+func tableRow(cells []string) string {
+	escaped := make([]string, len(cells))
+	for i, cell := range cells {
+		escaped[i] = strings.NewReplacer("|", `\|`, "\n", " ").Replace(cell)
+	}
+
+	return "| " + strings.Join(escaped, " | ") + " |\n"
+}
+
+// SplitTables renders tables as Markdown split into parts no longer than maxLength. Tables that do not fit in a
+// single part are split between rows, with the header repeated in every part.
+//
+// Parameters:
+//   - tables: The tables to render. Required.
+//   - maxLength: The maximum length of a part, in bytes. Required.
+//
+// Returns:
+//   - []string: The Markdown parts, in order.
+//   - error: An error object if a single row, with its header, is longer than maxLength.
+func SplitTables(tables []Table, maxLength int) ([]string, error) {
+	var (
+		parts   []string
+		current strings.Builder
+	)
+
+	flush := func() {
+		if current.Len() > 0 {
+			parts = append(parts, strings.TrimRight(current.String(), "\n"))
+			current.Reset()
+		}
+	}
+	write := func(chunk string) error {
+		if current.Len() > 0 && current.Len()+len(chunk)+1 > maxLength {
+			flush()
+		}
+		if len(chunk) > maxLength {
+			return fmt.Errorf("SplitTables: content of %d bytes does not fit in %d", len(chunk), maxLength)
+		}
+		if current.Len() > 0 {
+			current.WriteString("\n")
+		}
+		current.WriteString(chunk)
+		return nil
+	}
+
+	for _, table := range tables {
+		if rendered := table.Markdown(); len(rendered) <= maxLength {
+			if err := write(rendered); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		// Split the table between rows, repeating the title and header in every chunk.
+		head := table
+		head.Rows = nil
+		prefix := head.Markdown()
+
+		chunk := prefix
+		for _, row := range table.Rows {
+			line := tableRow(row)
+			if len(chunk)+len(line) > maxLength && chunk != prefix {
+				if err := write(chunk); err != nil {
+					return nil, err
+				}
+				flush()
+				chunk = prefix
+			}
+			chunk += line
+		}
+		if err := write(chunk); err != nil {
+			return nil, err
+		}
+	}
+	flush()
+
+	return parts, nil
+}
+
+// PostDigest renders tables as Markdown and posts them as comments on a contract, splitting them across as many
+// comments as needed to stay within the length limit.
+//
+// Parameters:
+//   - id: The ID of the contract to comment on. Required.
+//   - tables: The tables to post. Required.
+//   - maxLength: The maximum length of a single comment, in bytes. Defaults to 8000 if 0. Optional.
+//
+// Returns:
+//   - int: The number of comments posted.
+//   - error: An error object if the tables cannot be split or if posting a comment fails. Later comments are not posted.
+func (s *CommentService) PostDigest(id string, tables []Table, maxLength int) (int, error) {
+	if maxLength <= 0 {
+		maxLength = defaultCommentLength
+	}
+
+	parts, err := SplitTables(tables, maxLength)
+	if err != nil {
+		return 0, fmt.Errorf("Comment: PostDigest: %w", err)
+	}
+
+	for i, part := range parts {
+		if err := s.CommentMarkdown(id, part); err != nil {
+			return i, fmt.Errorf("Comment: PostDigest: %w", err)
+		}
+	}
+
+	return len(parts), nil
+}
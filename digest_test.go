@@ -0,0 +1,49 @@
+package manifold
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSplitTables(t *testing.T) {
+	table := Table{Title: "Markets", Headers: []string{"Market", "Prob"}, Rows: [][]string{{"a|b", "10%"}, {"c\nd", "20%"}, {"e", "30%"}}}
+	small := Table{Headers: []string{"Total"}, Rows: [][]string{{"3"}}}
+
+	rendered := table.Markdown()
+	if !strings.Contains(rendered, `| a\|b | 10% |`) || !strings.Contains(rendered, "| c d | 20% |") {
+		t.Errorf("Markdown() = %q, want pipes and newlines escaped", rendered)
+	}
+
+	head := "### Markets\n\n| Market | Prob |\n| --- | --- |\n"
+	tests := []struct {
+		name      string
+		tables    []Table
+		maxLength int
+		want      []string
+		wantErr   bool
+	}{
+		{"everything fits", []Table{table, small}, 1000, []string{rendered + "\n" + strings.TrimRight(small.Markdown(), "\n")}, false},
+		{"tables split into parts", []Table{table, small}, len(rendered), []string{strings.TrimRight(rendered, "\n"), strings.TrimRight(small.Markdown(), "\n")}, false},
+		{"table split between rows", []Table{table}, len(head) + 30, []string{
+			head + "| a\\|b | 10% |\n| c d | 20% |",
+			head + "| e | 30% |",
+		}, false},
+		{"row too long", []Table{table}, len(head), nil, true},
+	}
+
+	for _, tt := range tests {
+		parts, err := SplitTables(tt.tables, tt.maxLength)
+		if (err != nil) != tt.wantErr {
+			t.Fatalf("%s: SplitTables() error = %v, want error %v", tt.name, err, tt.wantErr)
+		}
+
+		if strings.Join(parts, "\x00") != strings.Join(tt.want, "\x00") {
+			t.Errorf("%s: SplitTables() = %q, want %q", tt.name, parts, tt.want)
+		}
+		for _, part := range parts {
+			if len(part) > tt.maxLength {
+				t.Errorf("%s: part of %d bytes exceeds %d", tt.name, len(part), tt.maxLength)
+			}
+		}
+	}
+}
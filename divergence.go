@@ -0,0 +1,231 @@
+package manifold
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+)
+
+// DivergenceAlert reports a market diverging from, or converging back to, its fair value.
+type DivergenceAlert struct {
+	ContractID  string    // ID of the market
+	Probability float64   // Current probability of the market
+	FairValue   float64   // Current fair value estimate
+	Divergence  float64   // Probability minus fair value
+	Since       time.Time // Time the divergence started
+	Cleared     bool      // Whether the divergence has ended, rather than started
+	Bet         *Bet      // Bet placed towards the fair value when the divergence started, if trading is enabled
+	Err         error     // Error that prevented the bet from being placed, if any
+}
+
+// divergenceState tracks the divergence of a single watched market.
+type divergenceState struct {
+	since   time.Time
+	alerted bool
+}
+
+// DivergenceMonitor alerts when the probability of watched markets diverges from a FairValueSource by more than a threshold
+// for longer than a dwell time. An alert is only cleared once the divergence falls below a lower clear threshold,
+// which avoids flapping around the threshold.
+type DivergenceMonitor struct {
+	client         *Client
+	source         FairValueSource
+	threshold      float64
+	clearThreshold float64
+	dwell          time.Duration
+	tradeAmount    *float64
+	onAlert        func(DivergenceAlert)
+
+	mu      sync.Mutex
+	markets map[string]*divergenceState
+}
+
+// NewDivergenceMonitor creates a new divergence monitor.
+//
+// Parameters:
+//   - client: The client used to poll markets and place bets. Required.
+//   - source: The source of fair value estimates. Required.
+//   - threshold: The absolute divergence that starts an alert. Must be between 0 and 1. Required.
+//   - clearThreshold: The absolute divergence below which an alert is cleared. Must not exceed threshold. Required.
+//   - dwell: How long the divergence must last before alerting. Required.
+//   - tradeAmount: If set, a bet of this amount is placed towards the fair value whenever an alert starts. Optional.
+//   - onAlert: Called for every started and cleared alert. Required.
+//
+// Returns:
+//   - *DivergenceMonitor: A pointer to the newly created monitor, with no markets watched.
+//   - error: An error object if input validation fails.
+func NewDivergenceMonitor(client *Client, source FairValueSource, threshold float64, clearThreshold float64, dwell time.Duration, tradeAmount *float64, onAlert func(DivergenceAlert)) (*DivergenceMonitor, error) {
+	if err := checkInRange(threshold, 0, 1); err != nil {
+		return nil, fmt.Errorf("DivergenceMonitor: NewDivergenceMonitor(threshold): %w", err)
+	}
+
+	if err := checkInRange(clearThreshold, 0, threshold); err != nil {
+		return nil, fmt.Errorf("DivergenceMonitor: NewDivergenceMonitor(clearThreshold): %w", err)
+	}
+
+	if tradeAmount != nil && *tradeAmount <= 0 {
+		return nil, fmt.Errorf("DivergenceMonitor: NewDivergenceMonitor(tradeAmount): invalid value: %f, value must be >0", *tradeAmount)
+	}
+
+	return &DivergenceMonitor{
+		client:         client,
+		source:         source,
+		threshold:      threshold,
+		clearThreshold: clearThreshold,
+		dwell:          dwell,
+		tradeAmount:    tradeAmount,
+		onAlert:        onAlert,
+		markets:        make(map[string]*divergenceState),
+	}, nil
+}
+
+// Watch starts monitoring a market.
+//
+// Parameters:
+//   - id: The ID of the binary market to monitor. Required.
+func (m *DivergenceMonitor) Watch(id string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.markets[id]; !ok {
+		m.markets[id] = &divergenceState{}
+	}
+}
+
+// Unwatch stops monitoring a market.
+//
+// Parameters:
+//   - id: The ID of the market to stop monitoring. Required.
+func (m *DivergenceMonitor) Unwatch(id string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.markets, id)
+}
+
+// Check polls every watched market and its fair value once, emitting alerts for divergences that start or clear.
+// Markets without a fair value are skipped.
+//
+// Returns:
+//   - error: An error object if a market or fair value could not be fetched. Remaining markets are still checked.
+func (m *DivergenceMonitor) Check() error {
+	m.mu.Lock()
+	ids := make([]string, 0, len(m.markets))
+	for id := range m.markets {
+		ids = append(ids, id)
+	}
+	m.mu.Unlock()
+
+	var firstErr error
+	for _, id := range ids {
+		if err := m.check(id); err != nil && firstErr == nil {
+			firstErr = fmt.Errorf("DivergenceMonitor: Check: %w", err)
+		}
+	}
+
+	return firstErr
+}
+
+// check polls a single market.
+func (m *DivergenceMonitor) check(id string) error {
+	fair, err := m.source.GetProbability(id)
+	if errors.Is(err, ErrorNoFairValue) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	market, err := m.client.Market.Market(id)
+	if err != nil {
+		return err
+	}
+
+	if market.Probability == nil {
+		return fmt.Errorf("market %s has no probability", id)
+	}
+
+	now := time.Now()
+	alert := DivergenceAlert{
+		ContractID:  id,
+		Probability: *market.Probability,
+		FairValue:   fair.Probability,
+		Divergence:  *market.Probability - fair.Probability,
+	}
+
+	m.mu.Lock()
+	state, ok := m.markets[id]
+	if !ok {
+		m.mu.Unlock()
+		return nil
+	}
+
+	var emit bool
+	switch abs := math.Abs(alert.Divergence); {
+	case abs >= m.threshold:
+		if state.since.IsZero() {
+			state.since = now
+		}
+
+		if !state.alerted && now.Sub(state.since) >= m.dwell {
+			state.alerted, emit = true, true
+		}
+
+	case abs < m.clearThreshold:
+		if state.alerted {
+			alert.Cleared, emit = true, true
+		}
+
+		alert.Since = state.since
+		*state = divergenceState{}
+	}
+
+	if !alert.Cleared {
+		alert.Since = state.since
+	}
+	m.mu.Unlock()
+
+	if !emit {
+		return nil
+	}
+
+	if !alert.Cleared && m.tradeAmount != nil {
+		outcome := "YES"
+		if alert.Divergence > 0 {
+			outcome = "NO"
+		}
+
+		// Limit the bet to the fair value so it does not overshoot the estimate.
+		limit := math.Round(math.Min(math.Max(fair.Probability, 0.01), 0.99)*100) / 100
+		alert.Bet, alert.Err = m.client.Bet.Create(*m.tradeAmount, id, &outcome, &limit, nil, nil)
+	}
+
+	m.onAlert(alert)
+
+	return nil
+}
+
+// Run calls Check at the given interval until stop is closed. Errors from Check are passed to onError.
+//
+// Parameters:
+//   - interval: The time between checks. Required.
+//   - stop: A channel that stops the monitor when closed. Required.
+//   - onError: Called with every error returned by Check. Optional.
+func (m *DivergenceMonitor) Run(interval time.Duration, stop <-chan struct{}, onError func(error)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := m.Check(); err != nil && onError != nil {
+			onError(err)
+		}
+
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package manifold
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+// GetJSON performs a GET request to the Manifold API and decodes the JSON response into a value of type T,
+// such as *FullMarket or []Bet. It can be used to call endpoints that are not wrapped by a service yet.
+//
+// Parameters:
+//   - c: The client to send the request with. Required.
+//   - endpoint: The API endpoint to send the GET request to (relative to BaseURL). Required.
+//   - params: A map of query parameters to include in the request. Optional.
+//
+// Returns:
+//   - T: The decoded response.
+//   - error: ErrorGETFailed if the request fails, or ErrorFailedToParseResponse if the response cannot be decoded,
+//     including a null response when T is a pointer.
+func GetJSON[T any](c *Client, endpoint string, params map[string]string) (T, error) {
+	result, err := c.GET(endpoint, params)
+	if err != nil {
+		var out T
+		return out, fmt.Errorf("%w: %w", ErrorGETFailed, err)
+	}
+
+	return decodeJSON[T](result)
+}
+
+// PostJSON performs a POST request to the Manifold API and decodes the JSON response into a value of type T,
+// such as *Bet. It can be used to call endpoints that are not wrapped by a service yet.
+//
+// Parameters:
+//   - c: The client to send the request with. Required.
+//   - endpoint: The API endpoint to send the POST request to (relative to BaseURL). Required.
+//   - body: The body to include in the POST request. Must be serializable to JSON. Optional.
+//
+// Returns:
+//   - T: The decoded response.
+//   - error: ErrorPOSTFailed if the request fails, or ErrorFailedToParseResponse if the response cannot be decoded,
+//     including a null response when T is a pointer.
+func PostJSON[T any](c *Client, endpoint string, body interface{}) (T, error) {
+	result, err := c.POST(endpoint, body)
+	if err != nil {
+		var out T
+		return out, fmt.Errorf("%w: %w", ErrorPOSTFailed, err)
+	}
+
+	return decodeJSON[T](result)
+}
+
+// decodeJSON decodes a response into a value of type T. A null response is rejected when T is a pointer, so that
+// callers never receive a nil pointer without an error.
+func decodeJSON[T any](result []byte) (T, error) {
+	var out T
+	if err := json.Unmarshal(result, &out); err != nil {
+		return out, fmt.Errorf("%w: %w", ErrorFailedToParseResponse, err)
+	}
+
+	if v := reflect.ValueOf(&out).Elem(); v.Kind() == reflect.Pointer && v.IsNil() {
+		return out, fmt.Errorf("%w: empty response", ErrorFailedToParseResponse)
+	}
+
+	return out, nil
+}
@@ -0,0 +1,46 @@
+package manifold
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetJSONRejectsNullPointer(t *testing.T) {
+	tests := []struct {
+		name    string
+		body    string
+		wantErr bool
+	}{
+		{name: "object", body: `{"id":"u1"}`},
+		{name: "empty object", body: `{}`},
+		{name: "null", body: `null`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			client := NewClient("key")
+			client.BaseURL = server.URL
+
+			user, err := GetJSON[*User](client, "/v0/me", nil)
+			if tt.wantErr {
+				if !errors.Is(err, ErrorFailedToParseResponse) {
+					t.Errorf("GetJSON() error = %v, want ErrorFailedToParseResponse", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("GetJSON() error = %v", err)
+			}
+			if user == nil {
+				t.Error("GetJSON() returned a nil user without an error")
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package manifold
+
+import "fmt"
+
+// Environment is a deployment of Manifold that a Client can talk to.
+type Environment struct {
+	Name    string // Short name of the environment (e.g., "prod")
+	BaseURL string // Base URL of the API
+	SiteURL string // Base URL of the website, used to build links to markets and users
+}
+
+var (
+	// EnvironmentProd is the production deployment at manifold.markets.
+	EnvironmentProd = Environment{
+		Name:    "prod",
+		BaseURL: "https://api.manifold.markets/v0",
+		SiteURL: "https://manifold.markets",
+	}
+
+	// EnvironmentDev is the development deployment at dev.manifold.markets. It has separate accounts, API keys,
+	// and mana, so bots can be developed against it without risking real balances.
+	EnvironmentDev = Environment{
+		Name:    "dev",
+		BaseURL: "https://api.dev.manifold.markets/v0",
+		SiteURL: "https://dev.manifold.markets",
+	}
+)
+
+// WithEnvironment makes the client talk to the given environment.
+//
+// Parameters:
+//   - env: The environment to use, usually EnvironmentProd or EnvironmentDev. Required.
+//
+// Returns:
+//   - Option: The option, to pass to NewClient.
+func WithEnvironment(env Environment) Option {
+	return func(c *Client) {
+		c.Environment = env
+		c.BaseURL = env.BaseURL
+	}
+}
+
+// RequireEnvironment checks that the client talks to the given environment. Test code that creates markets,
+// places bets, or sends mana should call it first, so that it cannot run against production by mistake.
+//
+// Parameters:
+//   - env: The environment the client is expected to use. Required.
+//
+// Returns:
+//   - error: An error object if the client uses a different environment.
+func (c *Client) RequireEnvironment(env Environment) error {
+	if c.Environment.Name != env.Name || c.BaseURL != env.BaseURL {
+		return fmt.Errorf("RequireEnvironment: client uses %s (%s), not %s", c.Environment.Name, c.BaseURL, env.Name)
+	}
+
+	return nil
+}
+
+// MarketURL returns the link to a market on the client's environment.
+//
+// Parameters:
+//   - creatorUsername: The username of the market's creator. Required.
+//   - slug: The slug of the market. Required.
+//
+// Returns:
+//   - string: The URL of the market.
+func (c *Client) MarketURL(creatorUsername string, slug string) string {
+	return fmt.Sprintf("%s/%s/%s", c.Environment.SiteURL, creatorUsername, slug)
+}
@@ -1,9 +1,83 @@
 package manifold
 
-import "errors"
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
 
 var (
 	ErrorGETFailed             = errors.New("GET failed")
 	ErrorPOSTFailed            = errors.New("POST failed")
 	ErrorFailedToParseResponse = errors.New("failed to parse response")
+	ErrorResolutionCheckFailed = errors.New("resolution check failed")
+	ErrorCommentRateLimited    = errors.New("comment rate limited")
+	ErrorDuplicateComment      = errors.New("duplicate comment")
+	ErrorContentBlocked        = errors.New("content blocked")
+	ErrorCheckpointFailed      = errors.New("checkpoint failed")
+	ErrorSelfMatch             = errors.New("order would match own resting order")
+	ErrorNoFairValue           = errors.New("no fair value")
+	ErrorSlippage              = errors.New("slippage bound exceeded")
+	ErrorBankrollPolicy        = errors.New("bankroll policy exceeded")
+	ErrorRateLimited           = errors.New("rate limited")
+	ErrorDraftRejected         = errors.New("generated draft rejected")
+	ErrorMarketClosing         = errors.New("market closing too soon")
+	ErrorCircuitOpen           = errors.New("circuit open")
+	ErrorPaperBudget           = errors.New("paper budget exceeded")
 )
+
+// RateLimitError is returned when the API rejects a request for rate limiting. It wraps ErrorRateLimited,
+// so it can be detected with errors.Is, or inspected with errors.As for the time to wait before retrying.
+type RateLimitError struct {
+	RetryAfter time.Duration // Time to wait before retrying, from Retry-After or X-RateLimit-Reset, or 0 if not given
+	Reset      time.Time     // Time the rate limit window resets, from X-RateLimit-Reset, or the zero time if not given
+	Limit      int           // Number of requests allowed per window, from X-RateLimit-Limit, or 0 if not given
+}
+
+// Error returns the error message.
+func (e *RateLimitError) Error() string {
+	if e.RetryAfter > 0 {
+		return fmt.Sprintf("%s: retry after %s", ErrorRateLimited, e.RetryAfter)
+	}
+
+	return ErrorRateLimited.Error()
+}
+
+// Unwrap returns ErrorRateLimited.
+func (e *RateLimitError) Unwrap() error {
+	return ErrorRateLimited
+}
+
+// APIError is returned when the API responds with a status code other than 2xx.
+type APIError struct {
+	StatusCode int               // HTTP status code of the response
+	Message    string            // Error message returned by the API, or the raw body if it has none
+	Endpoint   string            // Endpoint the request was sent to
+	Params     map[string]string // Query parameters of a GET request, or top-level fields of a POST body
+	RequestID  string            // ID of the request from the response headers, if sent, for support tickets
+}
+
+// Error returns the error message.
+func (e *APIError) Error() string {
+	return fmt.Sprintf("API error %d on %s: %s", e.StatusCode, e.Endpoint, e.Message)
+}
+
+// newAPIError creates an APIError from the body of a response, extracting the message from a JSON error body.
+func newAPIError(statusCode int, endpoint string, params map[string]string, body []byte) *APIError {
+	var errorBody struct {
+		Message string `json:"message"`
+	}
+
+	message := strings.TrimSpace(string(body))
+	if err := json.Unmarshal(body, &errorBody); err == nil && errorBody.Message != "" {
+		message = errorBody.Message
+	}
+	if message == "" {
+		message = http.StatusText(statusCode)
+	}
+
+	return &APIError{StatusCode: statusCode, Message: message, Endpoint: endpoint, Params: params}
+}
@@ -0,0 +1,441 @@
+package manifold
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// ExecutionReport is the progress of a managed execution algorithm.
+type ExecutionReport struct {
+	Target       float64 // Total amount to spend
+	Spent        float64 // Amount spent so far
+	Shares       float64 // Shares bought so far
+	Orders       int     // Number of child orders placed
+	Skipped      int     // Number of child orders skipped because the market was beyond the probability bound
+	AveragePrice float64 // Average price paid per share so far
+	Done         bool    // Whether the execution has finished or been cancelled
+}
+
+// executeChild places a child order that fills at most up to limitProb and cancels any unfilled remainder.
+// If the cancellation fails, the order is returned along with the error, as its fill must still be recorded.
+func executeChild(client *Client, contractID string, outcome string, amount float64, limitProb float64) (*Bet, error) {
+	bet, err := client.Bet.Create(amount, contractID, &outcome, &limitProb, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if bet.LimitProps != nil && !bet.LimitProps.IsFilled && !bet.LimitProps.IsCancelled {
+		if err := client.Bet.Cancel(bet.ID); err != nil {
+			return bet, fmt.Errorf("remainder of order %s left open: %w", bet.ID, err)
+		}
+	}
+
+	return bet, nil
+}
+
+// record adds the fill of a child order to the report.
+func (r *ExecutionReport) record(bet *Bet) {
+	r.Orders++
+	r.Spent += bet.Amount
+	r.Shares += bet.Shares
+	if r.Shares > 0 {
+		r.AveragePrice = r.Spent / r.Shares
+	}
+}
+
+// beyondBound reports whether the probability of a market is already beyond the limit of a child order for an outcome.
+func beyondBound(prob float64, outcome string, limitProb float64) bool {
+	if outcome == "YES" {
+		return prob >= limitProb
+	}
+
+	return prob <= limitProb
+}
+
+// Iceberg works a large order gradually through randomized child orders at randomized intervals,
+// to avoid telegraphing the full size to other traders.
+type Iceberg struct {
+	client      *Client
+	contractID  string
+	outcome     string
+	limitProb   float64
+	minChild    float64
+	maxChild    float64
+	minInterval time.Duration
+	maxInterval time.Duration
+	rng         *rand.Rand
+
+	step   sync.Mutex // Held for a whole step, so steps do not overlap
+	mu     sync.Mutex // Guards the fields below, and is not held across requests
+	report ExecutionReport
+}
+
+// NewIceberg creates a new iceberg order. No child orders are placed until Step or Run is called.
+//
+// Parameters:
+//   - client: The client used to place child orders. Required.
+//   - contractID: The ID of the binary market to trade in. Required.
+//   - outcome: The outcome to buy ("YES" or "NO"). Required.
+//   - target: The total amount to spend. Must be greater than zero. Required.
+//   - limitProb: The probability bound child orders never fill beyond. Must be between 0 and 1. Required.
+//   - minChild: The smallest child order amount. Must be greater than zero. Required.
+//   - maxChild: The largest child order amount. Must be at least minChild. Required.
+//   - minInterval: The shortest time between child orders. Required.
+//   - maxInterval: The longest time between child orders. Must be at least minInterval. Required.
+//
+// Returns:
+//   - *Iceberg: A pointer to the newly created iceberg order.
+//   - error: An error object if input validation fails.
+func NewIceberg(client *Client, contractID string, outcome string, target float64, limitProb float64, minChild float64, maxChild float64, minInterval time.Duration, maxInterval time.Duration) (*Iceberg, error) {
+	if err := checkOneOf(outcome, "YES", "NO"); err != nil {
+		return nil, fmt.Errorf("Iceberg: NewIceberg(outcome): %w", err)
+	}
+
+	if target <= 0 {
+		return nil, fmt.Errorf("Iceberg: NewIceberg(target): invalid value: %f, value must be >0", target)
+	}
+
+	if err := checkInRange(limitProb, 0, 1); err != nil {
+		return nil, fmt.Errorf("Iceberg: NewIceberg(limitProb): %w", err)
+	}
+
+	if minChild <= 0 || maxChild < minChild {
+		return nil, fmt.Errorf("Iceberg: NewIceberg: child order sizes must satisfy 0 < minChild <= maxChild")
+	}
+
+	if minInterval < 0 || maxInterval < minInterval {
+		return nil, fmt.Errorf("Iceberg: NewIceberg: intervals must satisfy 0 <= minInterval <= maxInterval")
+	}
+
+	return &Iceberg{
+		client:      client,
+		contractID:  contractID,
+		outcome:     outcome,
+		limitProb:   limitProb,
+		minChild:    minChild,
+		maxChild:    maxChild,
+		minInterval: minInterval,
+		maxInterval: maxInterval,
+		rng:         rand.New(rand.NewSource(time.Now().UnixNano())),
+		report:      ExecutionReport{Target: target},
+	}, nil
+}
+
+// Report returns the current progress of the iceberg order.
+func (i *Iceberg) Report() ExecutionReport {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	return i.report
+}
+
+// Cancel stops the iceberg order from placing further child orders.
+func (i *Iceberg) Cancel() {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.report.Done = true
+}
+
+// Step places a single child order of random size, unless the market is already beyond the probability bound.
+//
+// Returns:
+//   - error: An error object if a request fails. The fill of a child order whose remainder cannot be cancelled is
+//     still recorded.
+func (i *Iceberg) Step() error {
+	i.step.Lock()
+	defer i.step.Unlock()
+
+	i.mu.Lock()
+	done := i.report.Done
+	i.mu.Unlock()
+
+	if done {
+		return nil
+	}
+
+	market, err := i.client.Market.Market(i.contractID)
+	if err != nil {
+		return fmt.Errorf("Iceberg: Step: %w", err)
+	}
+
+	i.mu.Lock()
+	if market.Probability == nil || beyondBound(*market.Probability, i.outcome, i.limitProb) {
+		i.report.Skipped++
+		i.mu.Unlock()
+		return nil
+	}
+
+	size := i.minChild + i.rng.Float64()*(i.maxChild-i.minChild)
+	size = math.Min(math.Max(math.Round(size), 1), i.report.Target-i.report.Spent)
+	i.mu.Unlock()
+
+	bet, err := executeChild(i.client, i.contractID, i.outcome, size, i.limitProb)
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	if bet != nil {
+		i.report.record(bet)
+	}
+
+	// Stop once the remainder is too small for another child order.
+	if i.report.Target-i.report.Spent < 1 {
+		i.report.Done = true
+	}
+
+	if err != nil {
+		return fmt.Errorf("Iceberg: Step: %w", err)
+	}
+
+	return nil
+}
+
+// Run places child orders at random intervals until the target is reached, the order is cancelled, or stop is closed.
+//
+// Parameters:
+//   - stop: A channel that stops the iceberg order when closed. Required.
+//   - onError: Called with every error returned by Step. Optional.
+//
+// Returns:
+//   - ExecutionReport: The final progress of the iceberg order.
+func (i *Iceberg) Run(stop <-chan struct{}, onError func(error)) ExecutionReport {
+	for {
+		if err := i.Step(); err != nil && onError != nil {
+			onError(err)
+		}
+
+		i.mu.Lock()
+		done := i.report.Done
+		wait := i.minInterval + time.Duration(i.rng.Int63n(int64(i.maxInterval-i.minInterval)+1))
+		i.mu.Unlock()
+
+		if done {
+			return i.Report()
+		}
+
+		select {
+		case <-stop:
+			return i.Report()
+		case <-time.After(wait):
+		}
+	}
+}
+
+// SlicedExecution spends a target amount over a fixed duration in equally spaced slices.
+// In TWAP mode every slice spends an equal share of the target; in VWAP mode every slice spends a fixed fraction of the
+// volume traded in the market since the previous slice, so spending follows the market's own volume profile.
+// Slices are skipped while the market is beyond the probability bound.
+type SlicedExecution struct {
+	client        *Client
+	contractID    string
+	outcome       string
+	limitProb     float64
+	duration      time.Duration
+	slices        int
+	participation float64
+
+	step       sync.Mutex // Held for a whole step, so steps do not overlap
+	mu         sync.Mutex // Guards the fields below, and is not held across requests
+	report     ExecutionReport
+	slice      int
+	lastVolume *float64
+	lastSpent  float64 // Amount spent when lastVolume was measured
+}
+
+// NewTWAPExecution creates a time-sliced execution that spends an equal share of the target in every slice.
+//
+// Parameters:
+//   - client: The client used to place orders. Required.
+//   - contractID: The ID of the binary market to trade in. Required.
+//   - outcome: The outcome to buy ("YES" or "NO"). Required.
+//   - target: The total amount to spend. Must be greater than zero. Required.
+//   - limitProb: The probability bound orders never fill beyond. Must be between 0 and 1. Required.
+//   - duration: The time to spread the execution over. Must be greater than zero. Required.
+//   - slices: The number of slices. Must be greater than zero. Required.
+//
+// Returns:
+//   - *SlicedExecution: A pointer to the newly created execution.
+//   - error: An error object if input validation fails.
+func NewTWAPExecution(client *Client, contractID string, outcome string, target float64, limitProb float64, duration time.Duration, slices int) (*SlicedExecution, error) {
+	return newSlicedExecution(client, contractID, outcome, target, limitProb, duration, slices, 0)
+}
+
+// NewVWAPExecution creates a time-sliced execution that spends a fraction of the market volume traded by others since
+// the previous slice, capped by the remaining target. The first slice only measures the volume.
+//
+// Parameters:
+//   - client: The client used to place orders. Required.
+//   - contractID: The ID of the binary market to trade in. Required.
+//   - outcome: The outcome to buy ("YES" or "NO"). Required.
+//   - target: The total amount to spend. Must be greater than zero. Required.
+//   - limitProb: The probability bound orders never fill beyond. Must be between 0 and 1. Required.
+//   - duration: The time to spread the execution over. Must be greater than zero. Required.
+//   - slices: The number of slices. Must be greater than zero. Required.
+//   - participation: The fraction of market volume to spend in every slice. Must be between 0 and 1. Required.
+//
+// Returns:
+//   - *SlicedExecution: A pointer to the newly created execution.
+//   - error: An error object if input validation fails.
+func NewVWAPExecution(client *Client, contractID string, outcome string, target float64, limitProb float64, duration time.Duration, slices int, participation float64) (*SlicedExecution, error) {
+	if participation <= 0 || participation > 1 {
+		return nil, fmt.Errorf("SlicedExecution: NewVWAPExecution(participation): invalid value: %f, must be within range (0, 1]", participation)
+	}
+
+	return newSlicedExecution(client, contractID, outcome, target, limitProb, duration, slices, participation)
+}
+
+// newSlicedExecution validates the parameters shared by TWAP and VWAP executions.
+func newSlicedExecution(client *Client, contractID string, outcome string, target float64, limitProb float64, duration time.Duration, slices int, participation float64) (*SlicedExecution, error) {
+	if err := checkOneOf(outcome, "YES", "NO"); err != nil {
+		return nil, fmt.Errorf("SlicedExecution: newSlicedExecution(outcome): %w", err)
+	}
+
+	if target <= 0 {
+		return nil, fmt.Errorf("SlicedExecution: newSlicedExecution(target): invalid value: %f, value must be >0", target)
+	}
+
+	if err := checkInRange(limitProb, 0, 1); err != nil {
+		return nil, fmt.Errorf("SlicedExecution: newSlicedExecution(limitProb): %w", err)
+	}
+
+	if duration <= 0 || slices <= 0 {
+		return nil, fmt.Errorf("SlicedExecution: newSlicedExecution: duration and slices must be greater than zero")
+	}
+
+	return &SlicedExecution{
+		client:        client,
+		contractID:    contractID,
+		outcome:       outcome,
+		limitProb:     limitProb,
+		duration:      duration,
+		slices:        slices,
+		participation: participation,
+		report:        ExecutionReport{Target: target},
+	}, nil
+}
+
+// Report returns the current progress of the execution.
+func (e *SlicedExecution) Report() ExecutionReport {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	return e.report
+}
+
+// Cancel stops the execution from placing further orders.
+func (e *SlicedExecution) Cancel() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.report.Done = true
+}
+
+// Step executes the next slice. The execution is done once every slice has been executed.
+//
+// Returns:
+//   - error: An error object if a request fails. The slice still counts as executed, and the fill of a child order
+//     whose remainder cannot be cancelled is still recorded.
+func (e *SlicedExecution) Step() error {
+	e.step.Lock()
+	defer e.step.Unlock()
+
+	e.mu.Lock()
+	if e.report.Done {
+		e.mu.Unlock()
+		return nil
+	}
+
+	e.slice++
+	if e.slice >= e.slices {
+		e.report.Done = true
+	}
+	e.mu.Unlock()
+
+	market, err := e.client.Market.Market(e.contractID)
+	if err != nil {
+		return fmt.Errorf("SlicedExecution: Step: %w", err)
+	}
+
+	amount, ok := e.sliceAmount(market.Volume, market.Probability)
+	if !ok {
+		return nil
+	}
+
+	bet, err := executeChild(e.client, e.contractID, e.outcome, amount, e.limitProb)
+	if bet != nil {
+		e.mu.Lock()
+		e.report.record(bet)
+		e.mu.Unlock()
+	}
+
+	if err != nil {
+		return fmt.Errorf("SlicedExecution: Step: %w", err)
+	}
+
+	return nil
+}
+
+// sliceAmount returns the amount to spend in the current slice given the market's volume and probability, and
+// whether a child order should be placed.
+func (e *SlicedExecution) sliceAmount(volume float64, prob *float64) (float64, bool) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	remaining := e.report.Target - e.report.Spent
+	amount := remaining / float64(e.slices-e.slice+1)
+	if e.participation > 0 {
+		previous, spent := e.lastVolume, e.lastSpent
+		e.lastVolume, e.lastSpent = &volume, e.report.Spent
+		if previous == nil {
+			return 0, false
+		}
+
+		// The execution's own orders are part of the volume, but must not drive further orders.
+		amount = e.participation * (volume - *previous - (e.report.Spent - spent))
+	}
+	amount = math.Min(math.Floor(amount), math.Floor(remaining))
+
+	if amount < 1 {
+		return 0, false
+	}
+
+	if prob == nil || beyondBound(*prob, e.outcome, e.limitProb) {
+		e.report.Skipped++
+		return 0, false
+	}
+
+	return amount, true
+}
+
+// Run executes one slice per interval until every slice has been executed, the execution is cancelled, or stop is closed.
+//
+// Parameters:
+//   - stop: A channel that stops the execution when closed. Required.
+//   - onError: Called with every error returned by Step. Optional.
+//
+// Returns:
+//   - ExecutionReport: The final progress of the execution.
+func (e *SlicedExecution) Run(stop <-chan struct{}, onError func(error)) ExecutionReport {
+	ticker := time.NewTicker(e.duration / time.Duration(e.slices))
+	defer ticker.Stop()
+
+	for {
+		if err := e.Step(); err != nil && onError != nil {
+			onError(err)
+		}
+
+		if e.Report().Done {
+			return e.Report()
+		}
+
+		select {
+		case <-stop:
+			return e.Report()
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,107 @@
+package manifold
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestVWAPExecutionExcludesOwnVolume(t *testing.T) {
+	var volume float64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/market/m":
+			fmt.Fprintf(w, `{"id":"m","probability":0.5,"volume":%f}`, volume)
+		case "/bet":
+			var body map[string]string
+			decodeBody(t, r, &body)
+			var amount float64
+			fmt.Sscan(body["amount"], &amount)
+			volume += amount
+			fmt.Fprintf(w, `{"id":"b","amount":%f,"shares":%f}`, amount, 2*amount)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("key")
+	client.BaseURL = server.URL
+
+	execution, err := NewVWAPExecution(client, "m", "YES", 1000, 0.9, time.Hour, 10, 0.5)
+	if err != nil {
+		t.Fatalf("NewVWAPExecution() error = %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		others float64 // Volume traded by others before the step
+		spent  float64 // Total spent after the step
+	}{
+		{"measures the volume", 100, 0},
+		{"follows the volume of others", 100, 50},
+		{"ignores its own volume", 0, 50},
+		{"follows again", 40, 70},
+	}
+
+	for _, tt := range tests {
+		volume += tt.others
+		if err := execution.Step(); err != nil {
+			t.Fatalf("%s: Step() error = %v", tt.name, err)
+		}
+		if got := execution.Report().Spent; got != tt.spent {
+			t.Errorf("%s: spent %v, want %v", tt.name, got, tt.spent)
+		}
+	}
+}
+
+func TestExecutionRecordsFillWhenCancelFails(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/market/m":
+			w.Write([]byte(`{"id":"m","probability":0.5}`))
+		case "/bet":
+			w.Write([]byte(`{"id":"b","amount":6,"shares":12,"limitProps":{"orderAmount":10,"limitProb":0.6}}`))
+		case "/bet/cancel/b":
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"message":"cannot cancel"}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("key")
+	client.BaseURL = server.URL
+
+	iceberg, err := NewIceberg(client, "m", "YES", 20, 0.6, 10, 10, 0, 0)
+	if err != nil {
+		t.Fatalf("NewIceberg() error = %v", err)
+	}
+	twap, err := NewTWAPExecution(client, "m", "YES", 20, 0.6, time.Hour, 2)
+	if err != nil {
+		t.Fatalf("NewTWAPExecution() error = %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		step   func() error
+		report func() ExecutionReport
+	}{
+		{"iceberg", iceberg.Step, iceberg.Report},
+		{"twap", twap.Step, twap.Report},
+	}
+
+	for _, tt := range tests {
+		if err := tt.step(); err == nil {
+			t.Errorf("%s: Step() error = nil, want the cancellation error", tt.name)
+		}
+
+		report := tt.report()
+		if report.Orders != 1 || report.Spent != 6 || report.Shares != 12 {
+			t.Errorf("%s: report = %+v, want the partial fill recorded", tt.name, report)
+		}
+	}
+}
@@ -0,0 +1,189 @@
+package manifold
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+)
+
+// FairValue is an externally sourced estimate of the probability of a market.
+type FairValue struct {
+	Probability float64   // Estimated probability of the market resolving YES
+	UpdatedAt   time.Time // Time the estimate was last updated by its source
+}
+
+// Age returns how long ago the estimate was last updated.
+func (v FairValue) Age() time.Duration {
+	return time.Since(v.UpdatedAt)
+}
+
+// FairValueSource provides fair value estimates for markets, such as the output of a user's own model.
+type FairValueSource interface {
+	// GetProbability returns the fair value estimate for a market, or an error wrapping ErrorNoFairValue if there is none.
+	GetProbability(marketID string) (*FairValue, error)
+}
+
+// StaticFairValues is a fair value source backed by a fixed set of estimates, keyed by market ID.
+type StaticFairValues map[string]FairValue
+
+// GetProbability returns the estimate stored for a market.
+func (s StaticFairValues) GetProbability(marketID string) (*FairValue, error) {
+	value, ok := s[marketID]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrorNoFairValue, marketID)
+	}
+
+	return &value, nil
+}
+
+// fairValueDocument is the JSON format read by LoadFairValues and HTTPFairValues: a map from market ID to estimate.
+type fairValueDocument map[string]struct {
+	Probability float64 `json:"probability"`         // Estimated probability
+	UpdatedAt   *int64  `json:"updatedAt,omitempty"` // Timestamp of the estimate in milliseconds (optional)
+}
+
+// decodeFairValues decodes a fair value document, stamping estimates without a timestamp with the given time.
+func decodeFairValues(data []byte, now time.Time) (StaticFairValues, error) {
+	document := make(fairValueDocument)
+	if err := json.Unmarshal(data, &document); err != nil {
+		return nil, err
+	}
+
+	values := make(StaticFairValues, len(document))
+	for id, entry := range document {
+		if err := checkInRange(entry.Probability, 0, 1); err != nil {
+			return nil, fmt.Errorf("market %s: %w", id, err)
+		}
+
+		updatedAt := now
+		if entry.UpdatedAt != nil {
+			updatedAt = time.UnixMilli(*entry.UpdatedAt)
+		}
+
+		values[id] = FairValue{Probability: entry.Probability, UpdatedAt: updatedAt}
+	}
+
+	return values, nil
+}
+
+// LoadFairValues reads fair value estimates from a JSON file mapping market IDs to objects with a "probability"
+// and an optional "updatedAt" timestamp in milliseconds. Estimates without a timestamp use the file's modification time.
+//
+// Parameters:
+//   - path: The path of the JSON file. Required.
+//
+// Returns:
+//   - StaticFairValues: The estimates read from the file.
+//   - error: An error object if the file cannot be read or parsed.
+func LoadFairValues(path string) (StaticFairValues, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("LoadFairValues: %w", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("LoadFairValues: %w", err)
+	}
+
+	values, err := decodeFairValues(data, info.ModTime())
+	if err != nil {
+		return nil, fmt.Errorf("LoadFairValues: %w: %w", ErrorFailedToParseResponse, err)
+	}
+
+	return values, nil
+}
+
+// HTTPFairValues is a fair value source that fetches estimates from an HTTP endpoint serving the same JSON format
+// as LoadFairValues. The document is cached and refetched once it is older than the refresh interval.
+type HTTPFairValues struct {
+	URL        string        // URL of the JSON document
+	Refresh    time.Duration // How long a fetched document is reused for
+	HTTPClient *http.Client  // HTTP client used to fetch the document. Defaults to http.DefaultClient if nil.
+
+	mu        sync.Mutex
+	values    StaticFairValues
+	fetchedAt time.Time
+}
+
+// GetProbability returns the estimate for a market from the cached document, fetching it first if needed.
+func (s *HTTPFairValues) GetProbability(marketID string) (*FairValue, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.values == nil || time.Since(s.fetchedAt) >= s.Refresh {
+		if err := s.fetch(); err != nil {
+			return nil, fmt.Errorf("HTTPFairValues: GetProbability: %w", err)
+		}
+	}
+
+	return s.values.GetProbability(marketID)
+}
+
+// fetch downloads and decodes the document. Must be called with the mutex held.
+func (s *HTTPFairValues) fetch() error {
+	httpClient := s.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	resp, err := httpClient.Get(s.URL)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %s", resp.Status)
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	values, err := decodeFairValues(data, now)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrorFailedToParseResponse, err)
+	}
+
+	s.values, s.fetchedAt = values, now
+
+	return nil
+}
+
+// MarketFairValues is a fair value source that uses the probability of other Manifold markets as estimates,
+// for example a large market as the reference for a smaller duplicate.
+type MarketFairValues struct {
+	Client     *Client           // Client used to fetch the reference markets
+	References map[string]string // ID of the reference market for every market ID
+}
+
+// GetProbability returns the current probability of the reference market of a market.
+func (s *MarketFairValues) GetProbability(marketID string) (*FairValue, error) {
+	reference, ok := s.References[marketID]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrorNoFairValue, marketID)
+	}
+
+	market, err := s.Client.Market.Market(reference)
+	if err != nil {
+		return nil, fmt.Errorf("MarketFairValues: GetProbability: %w", err)
+	}
+
+	if market.Probability == nil {
+		return nil, fmt.Errorf("MarketFairValues: GetProbability: %w: market %s has no probability", ErrorNoFairValue, reference)
+	}
+
+	updatedAt := time.Now()
+	if market.LastBetTime != nil {
+		updatedAt = time.UnixMilli(*market.LastBetTime)
+	}
+
+	return &FairValue{Probability: *market.Probability, UpdatedAt: updatedAt}, nil
+}
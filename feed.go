@@ -0,0 +1,262 @@
+package manifold
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"time"
+)
+
+// FeedItem is a single entry of a Feed.
+type FeedItem struct {
+	ID        string    // Unique and stable identifier of the entry
+	Title     string    // Title of the entry
+	Link      string    // Link to the market
+	Summary   string    // Short description of the entry
+	Published time.Time // Time of the event the entry is about
+}
+
+// Feed is a list of market events, such as new markets, resolutions, and big moves, that can be written as RSS or
+// Atom for feed readers.
+type Feed struct {
+	Title       string     // Title of the feed
+	Link        string     // Link to the website the feed is about
+	Description string     // Description of the feed
+	Items       []FeedItem // Entries, newest first
+}
+
+// Updated returns the time of the newest entry, or the zero time if the feed is empty.
+func (f *Feed) Updated() time.Time {
+	var updated time.Time
+	for _, item := range f.Items {
+		if item.Published.After(updated) {
+			updated = item.Published
+		}
+	}
+
+	return updated
+}
+
+// WriteRSS writes the feed as RSS 2.0.
+//
+// Parameters:
+//   - w: The writer to write the feed to. Required.
+//
+// Returns:
+//   - error: An error object if writing fails.
+func (f *Feed) WriteRSS(w io.Writer) error {
+	type guid struct {
+		IsPermaLink bool   `xml:"isPermaLink,attr"`
+		Value       string `xml:",chardata"`
+	}
+	type item struct {
+		Title       string `xml:"title"`
+		Link        string `xml:"link"`
+		Description string `xml:"description"`
+		GUID        guid   `xml:"guid"`
+		PubDate     string `xml:"pubDate"`
+	}
+	type channel struct {
+		Title         string `xml:"title"`
+		Link          string `xml:"link"`
+		Description   string `xml:"description"`
+		LastBuildDate string `xml:"lastBuildDate,omitempty"`
+		Items         []item `xml:"item"`
+	}
+	type rss struct {
+		XMLName xml.Name `xml:"rss"`
+		Version string   `xml:"version,attr"`
+		Channel channel  `xml:"channel"`
+	}
+
+	doc := rss{Version: "2.0", Channel: channel{Title: f.Title, Link: f.Link, Description: f.Description}}
+	if updated := f.Updated(); !updated.IsZero() {
+		doc.Channel.LastBuildDate = updated.Format(time.RFC1123Z)
+	}
+	for _, it := range f.Items {
+		doc.Channel.Items = append(doc.Channel.Items, item{
+			Title:       it.Title,
+			Link:        it.Link,
+			Description: it.Summary,
+			GUID:        guid{Value: it.ID},
+			PubDate:     it.Published.Format(time.RFC1123Z),
+		})
+	}
+
+	return writeXML(w, doc)
+}
+
+// WriteAtom writes the feed as Atom.
+//
+// Parameters:
+//   - w: The writer to write the feed to. Required.
+//
+// Returns:
+//   - error: An error object if writing fails.
+func (f *Feed) WriteAtom(w io.Writer) error {
+	type link struct {
+		Href string `xml:"href,attr"`
+	}
+	type entry struct {
+		ID      string `xml:"id"`
+		Title   string `xml:"title"`
+		Link    link   `xml:"link"`
+		Summary string `xml:"summary"`
+		Updated string `xml:"updated"`
+	}
+	type feed struct {
+		XMLName  xml.Name `xml:"http://www.w3.org/2005/Atom feed"`
+		ID       string   `xml:"id"`
+		Title    string   `xml:"title"`
+		Subtitle string   `xml:"subtitle,omitempty"`
+		Link     link     `xml:"link"`
+		Updated  string   `xml:"updated"`
+		Entries  []entry  `xml:"entry"`
+	}
+
+	doc := feed{ID: f.Link, Title: f.Title, Subtitle: f.Description, Link: link{f.Link}, Updated: f.Updated().UTC().Format(time.RFC3339)}
+	for _, it := range f.Items {
+		doc.Entries = append(doc.Entries, entry{
+			ID:      "urn:manifold:" + it.ID,
+			Title:   it.Title,
+			Link:    link{it.Link},
+			Summary: it.Summary,
+			Updated: it.Published.UTC().Format(time.RFC3339),
+		})
+	}
+
+	return writeXML(w, doc)
+}
+
+// writeXML writes an indented XML document with its header.
+func writeXML(w io.Writer, doc any) error {
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(doc); err != nil {
+		return err
+	}
+
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+// sortFeedItems orders feed entries newest first.
+func sortFeedItems(items []FeedItem) {
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].Published.After(items[j].Published)
+	})
+}
+
+// WatchlistFeed builds a feed of the events on a set of markets since a point in time: their creation,
+// their resolution, and moves of their probability by at least a threshold.
+//
+// Parameters:
+//   - title: The title of the feed. Required.
+//   - ids: The IDs of the markets to watch. Required.
+//   - since: Only include events from this time onwards. Required.
+//   - moveThreshold: The smallest absolute probability change since the given time to report, between 0 and 1. 0 disables move entries.
+//
+// Returns:
+//   - *Feed: A pointer to the feed.
+//   - error: An error object if a request fails or if the response cannot be parsed.
+func (s *MarketService) WatchlistFeed(title string, ids []string, since time.Time, moveThreshold float64) (*Feed, error) {
+	feed := &Feed{Title: title, Link: s.client.Environment.SiteURL, Description: "Activity on watched markets"}
+	sinceMs := since.UnixMilli()
+
+	for _, id := range ids {
+		market, err := s.Market(id)
+		if err != nil {
+			return nil, fmt.Errorf("Market: WatchlistFeed: %w", err)
+		}
+
+		if market.CreatedTime >= sinceMs {
+			feed.Items = append(feed.Items, FeedItem{
+				ID:        market.ID + ":created",
+				Title:     "New market: " + market.Question,
+				Link:      market.URL,
+				Summary:   market.Card().Summary,
+				Published: time.UnixMilli(market.CreatedTime),
+			})
+		}
+
+		if market.IsResolved && market.Resolution != nil && market.ResolutionTime != nil && *market.ResolutionTime >= sinceMs {
+			feed.Items = append(feed.Items, FeedItem{
+				ID:        market.ID + ":resolved",
+				Title:     fmt.Sprintf("Resolved %s: %s", *market.Resolution, market.Question),
+				Link:      market.URL,
+				Summary:   market.Card().Summary,
+				Published: time.UnixMilli(*market.ResolutionTime),
+			})
+		}
+
+		if moveThreshold <= 0 || market.Probability == nil || market.IsResolved {
+			continue
+		}
+
+		limit, order := 1, "asc"
+		first, err := s.client.Bet.Bets(nil, nil, &id, nil, &limit, nil, nil, nil, &since, nil, &order)
+		if err != nil {
+			return nil, fmt.Errorf("Market: WatchlistFeed: %w", err)
+		}
+
+		if len(first) == 0 || market.LastBetTime == nil {
+			continue
+		}
+
+		if move := *market.Probability - first[0].ProbBefore; math.Abs(move) >= moveThreshold {
+			feed.Items = append(feed.Items, FeedItem{
+				ID:        fmt.Sprintf("%s:move:%d", market.ID, *market.LastBetTime),
+				Title:     fmt.Sprintf("%s %s: %s", FormatProbChange(move, 0), FormatProb(*market.Probability, 0), market.Question),
+				Link:      market.URL,
+				Summary:   fmt.Sprintf("Moved from %s to %s", FormatProb(first[0].ProbBefore, 0), FormatProb(*market.Probability, 0)),
+				Published: time.UnixMilli(*market.LastBetTime),
+			})
+		}
+	}
+
+	sortFeedItems(feed.Items)
+
+	return feed, nil
+}
+
+// TopicFeed builds a feed of the newest markets in a topic.
+//
+// Parameters:
+//   - topicSlug: The slug of the topic. Required.
+//   - limit: The number of markets to include. Must be between 0 and 1000. Required.
+//
+// Returns:
+//   - *Feed: A pointer to the feed.
+//   - error: An error object if the request fails or if input validation fails.
+func (s *MarketService) TopicFeed(topicSlug string, limit int) (*Feed, error) {
+	newest := "newest"
+	markets, err := s.Search("", &newest, nil, nil, &topicSlug, nil, &limit, nil)
+	if err != nil {
+		return nil, fmt.Errorf("Market: TopicFeed: %w", err)
+	}
+
+	feed := &Feed{
+		Title:       "New markets in " + topicSlug,
+		Link:        fmt.Sprintf("%s/topic/%s", s.client.Environment.SiteURL, topicSlug),
+		Description: "Newest markets in the " + topicSlug + " topic",
+	}
+	for _, market := range markets {
+		feed.Items = append(feed.Items, FeedItem{
+			ID:        market.ID + ":created",
+			Title:     market.Question,
+			Link:      market.URL,
+			Summary:   fmt.Sprintf("Created by %s", market.CreatorName),
+			Published: time.UnixMilli(market.CreatedTime),
+		})
+	}
+
+	sortFeedItems(feed.Items)
+
+	return feed, nil
+}
@@ -0,0 +1,164 @@
+package manifold
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// FillEvent reports new fills on a limit order.
+type FillEvent struct {
+	Bet          *Bet    // State of the order when the fills were detected
+	Fills        []Fill  // Fills since the previous event for the order
+	FilledAmount float64 // Total amount filled so far
+	FilledShares float64 // Total shares filled so far
+	AveragePrice float64 // Average price of all fills so far
+	Done         bool    // Whether the order is fully filled, cancelled, or expired
+}
+
+// FillWatcher detects new fills on the limit orders of a user by polling their open orders.
+type FillWatcher struct {
+	client *Client
+	userID string
+	onFill func(FillEvent)
+
+	mu     sync.Mutex
+	primed bool
+	orders map[string]fillState
+}
+
+// fillState is the last seen state of an order tracked by a FillWatcher.
+type fillState struct {
+	contractID  string
+	createdTime int64
+	fills       int
+}
+
+// NewFillWatcher creates a new fill watcher. Fills that happened before the first Check are not reported.
+//
+// Parameters:
+//   - client: The client used to poll orders. Required.
+//   - userID: The ID of the user whose orders are watched, usually the authenticated user. Required.
+//   - onFill: Called for every order with new fills. Required.
+//
+// Returns:
+//   - *FillWatcher: A pointer to the newly created watcher.
+func NewFillWatcher(client *Client, userID string, onFill func(FillEvent)) *FillWatcher {
+	return &FillWatcher{
+		client: client,
+		userID: userID,
+		onFill: onFill,
+		orders: make(map[string]fillState),
+	}
+}
+
+// Check polls the open limit orders of the user once and emits events for any new fills. Orders that are no longer
+// open are fetched one last time so that their final fills are reported, and are then no longer tracked.
+//
+// Returns:
+//   - error: An error object if a request fails or if the response cannot be parsed.
+func (w *FillWatcher) Check() error {
+	limit, kinds := 1000, "open-limit"
+	open, err := w.client.Bet.Bets(&w.userID, nil, nil, nil, &limit, nil, nil, nil, nil, &kinds, nil)
+	if err != nil {
+		return fmt.Errorf("FillWatcher: Check: %w", err)
+	}
+
+	w.mu.Lock()
+	primed := w.primed
+	w.primed = true
+
+	seen := make(map[string]bool, len(open))
+	var changed []Bet
+	for _, bet := range open {
+		seen[bet.ID] = true
+		if bet.LimitProps == nil {
+			continue
+		}
+
+		previous, ok := w.orders[bet.ID]
+		if !ok {
+			previous = fillState{contractID: bet.ContractID, createdTime: bet.CreatedTime}
+			if !primed {
+				previous.fills = len(bet.LimitProps.Fills)
+			}
+			w.orders[bet.ID] = previous
+		}
+
+		if len(bet.LimitProps.Fills) > previous.fills {
+			changed = append(changed, bet)
+		}
+	}
+
+	closed := make(map[string]fillState)
+	for id, state := range w.orders {
+		if !seen[id] {
+			closed[id] = state
+		}
+	}
+	w.mu.Unlock()
+
+	for id, state := range closed {
+		bet, err := w.client.Bet.findBet(w.userID, state.contractID, id, state.createdTime)
+		if err != nil {
+			return fmt.Errorf("FillWatcher: Check: %w", err)
+		}
+
+		w.mu.Lock()
+		delete(w.orders, id)
+		w.mu.Unlock()
+
+		if bet != nil && bet.LimitProps != nil && len(bet.LimitProps.Fills) > state.fills {
+			w.emit(bet, state.fills, true)
+		}
+	}
+
+	for i := range changed {
+		w.mu.Lock()
+		state := w.orders[changed[i].ID]
+		w.orders[changed[i].ID] = fillState{changed[i].ContractID, changed[i].CreatedTime, len(changed[i].LimitProps.Fills)}
+		w.mu.Unlock()
+
+		w.emit(&changed[i], state.fills, false)
+	}
+
+	return nil
+}
+
+// emit reports the fills of an order after the first seen ones.
+func (w *FillWatcher) emit(bet *Bet, seen int, done bool) {
+	event := FillEvent{Bet: bet, Fills: bet.LimitProps.Fills[seen:], Done: done}
+
+	for _, fill := range bet.LimitProps.Fills {
+		event.FilledAmount += fill.Amount
+		event.FilledShares += fill.Shares
+	}
+	if event.FilledShares != 0 {
+		event.AveragePrice = event.FilledAmount / event.FilledShares
+	}
+
+	w.onFill(event)
+}
+
+// Run calls Check at the given interval until stop is closed. Errors from Check are passed to onError.
+//
+// Parameters:
+//   - interval: The time between checks. Required.
+//   - stop: A channel that stops the watcher when closed. Required.
+//   - onError: Called with every error returned by Check. Optional.
+func (w *FillWatcher) Run(interval time.Duration, stop <-chan struct{}, onError func(error)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := w.Check(); err != nil && onError != nil {
+			onError(err)
+		}
+
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
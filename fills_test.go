@@ -0,0 +1,53 @@
+package manifold
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFillWatcherCheck(t *testing.T) {
+	server := newOrderServer(t, []Bet{limitBet("a", "m1", 10, 0.4, 1)})
+	client := NewClient("key")
+	client.BaseURL = server.URL
+
+	type event struct {
+		id     string
+		fills  int
+		amount float64
+		done   bool
+	}
+	var events []event
+	watcher := NewFillWatcher(client, "u1", func(e FillEvent) {
+		events = append(events, event{e.Bet.ID, len(e.Fills), e.FilledAmount, e.Done})
+	})
+
+	tests := []struct {
+		name   string
+		update func()
+		want   []event
+	}{
+		{"fills before the first check are not reported", func() {}, nil},
+		{"new fills and new orders", func() {
+			server.bets[0].LimitProps.Fills = append(server.bets[0].LimitProps.Fills, Fill{Amount: 2})
+			server.bets = append(server.bets, limitBet("b", "m2", 10, 0.6, 4))
+		}, []event{{"a", 1, 3, false}, {"b", 1, 4, false}}},
+		{"no new fills", func() {}, nil},
+		{"final fills of a closed order", func() {
+			server.bets[0].LimitProps.Fills = append(server.bets[0].LimitProps.Fills, Fill{Amount: 7})
+			server.bets[0].LimitProps.IsFilled = true
+		}, []event{{"a", 1, 10, true}}},
+		{"closed orders are no longer tracked", func() {}, nil},
+	}
+
+	for _, tt := range tests {
+		tt.update()
+		events = nil
+		if err := watcher.Check(); err != nil {
+			t.Fatalf("%s: Check() error = %v", tt.name, err)
+		}
+
+		if !reflect.DeepEqual(events, tt.want) {
+			t.Errorf("%s: events = %+v, want %+v", tt.name, events, tt.want)
+		}
+	}
+}
@@ -0,0 +1,341 @@
+// Package fixtures generates realistic fake users, markets, bets and comments for tests, benchmarks and simulations.
+// A generator is seeded, so the same seed and calls always produce the same data, and the data is internally
+// consistent: bets and comments reference existing users and markets, happen after them, and bets move the market's
+// pool and probability the way the API would.
+package fixtures
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/e74000/manifold"
+)
+
+// idAlphabet is the alphabet of generated IDs, which look like Manifold's.
+const idAlphabet = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789"
+
+var (
+	firstNames = []string{"Ada", "Ben", "Chloe", "Dev", "Elena", "Farid", "Grace", "Hiro", "Ines", "Jonas", "Kemi", "Liam", "Maya", "Nikhil", "Olga", "Priya", "Quinn", "Rosa", "Sam", "Tariq"}
+	lastNames  = []string{"Abbott", "Baptiste", "Chen", "Diaz", "Eriksen", "Fischer", "Gupta", "Haddad", "Ito", "Jansen", "Kowalski", "Lopez", "Moreau", "Nakamura", "Okafor", "Park"}
+	subjects   = []string{"SpaceX", "the Fed", "OpenAI", "the S&P 500", "Bitcoin", "the UK government", "Taylor Swift", "the Lakers", "NASA", "Apple"}
+	events     = []string{"announce a new product", "cut interest rates", "reach an all-time high", "be in the news", "launch a rocket", "hold a press conference", "win their next game", "change leadership", "release an update", "beat expectations"}
+	remarks    = []string{"I think this is underpriced.", "Seems too high to me.", "Any sources on this?", "Resolution criteria are a bit vague.", "Bought some YES.", "Sold my position, too uncertain.", "Big news today, see the latest reports.", "This should be closer to 50%.", "Good question!", "What happens if it is delayed?"}
+)
+
+// Generator generates fake data from a seed.
+type Generator struct {
+	rand  *rand.Rand
+	start time.Time
+}
+
+// Dataset is a consistent set of fake data, as generated by Generator.Dataset.
+type Dataset struct {
+	Users    []manifold.User       // Users, ordered by creation time
+	Markets  []manifold.LiteMarket // Markets, in their state after all bets, ordered by creation time
+	Bets     []manifold.Bet        // Bets, ordered by creation time
+	Comments []manifold.Comment    // Comments, ordered by creation time
+}
+
+// New creates a new generator.
+//
+// Parameters:
+//   - seed: The seed of the generator. Required.
+//   - start: The time the generated data starts at. Users are created in the 30 days after it, and everything else after them. Required.
+//
+// Returns:
+//   - *Generator: A pointer to the newly created generator.
+func New(seed int64, start time.Time) *Generator {
+	return &Generator{rand: rand.New(rand.NewSource(seed)), start: start}
+}
+
+// Dataset generates users and binary markets created by them, with bets and comments from the users on every market.
+//
+// Parameters:
+//   - users: The number of users. Must be at least 1. Required.
+//   - markets: The number of markets. Required.
+//   - bets: The number of bets per market. Required.
+//   - comments: The number of comments per market. Required.
+//
+// Returns:
+//   - *Dataset: A pointer to the generated data.
+func (g *Generator) Dataset(users int, markets int, bets int, comments int) *Dataset {
+	data := &Dataset{Users: g.Users(users)}
+
+	for i := 0; i < markets; i++ {
+		market := g.Market(data.Users[g.rand.Intn(len(data.Users))])
+		data.Bets = append(data.Bets, g.Bets(&market, data.Users, bets)...)
+		data.Comments = append(data.Comments, g.Comments(market, data.Users, comments)...)
+		data.Markets = append(data.Markets, market)
+	}
+
+	sort.SliceStable(data.Markets, func(i, j int) bool { return data.Markets[i].CreatedTime < data.Markets[j].CreatedTime })
+	sort.SliceStable(data.Bets, func(i, j int) bool { return data.Bets[i].CreatedTime < data.Bets[j].CreatedTime })
+	sort.SliceStable(data.Comments, func(i, j int) bool { return data.Comments[i].CreatedTime < data.Comments[j].CreatedTime })
+
+	return data
+}
+
+// Users generates users, created in the 30 days after the start time.
+//
+// Parameters:
+//   - n: The number of users. Required.
+//
+// Returns:
+//   - []manifold.User: The users, ordered by creation time.
+func (g *Generator) Users(n int) []manifold.User {
+	users := make([]manifold.User, n)
+	for i := range users {
+		users[i] = g.User()
+	}
+
+	sort.SliceStable(users, func(i, j int) bool { return users[i].CreatedTime < users[j].CreatedTime })
+
+	return users
+}
+
+// User generates a user, created in the 30 days after the start time.
+//
+// Returns:
+//   - manifold.User: The user.
+func (g *Generator) User() manifold.User {
+	first := firstNames[g.rand.Intn(len(firstNames))]
+	last := lastNames[g.rand.Intn(len(lastNames))]
+	username := fmt.Sprintf("%s%s%d", first, last, g.rand.Intn(1000))
+
+	return manifold.User{
+		ID:          g.id(),
+		CreatedTime: g.after(g.start.UnixMilli(), 30*24*time.Hour),
+		Name:        first + " " + last,
+		Username:    username,
+		URL:         "https://manifold.markets/" + username,
+		Balance:     float64(100 + g.rand.Intn(10000)),
+	}
+}
+
+// Market generates an open binary CPMM market with a fresh pool, created after its creator and closing 7 to 90 days later.
+//
+// Parameters:
+//   - creator: The user who created the market. Required.
+//
+// Returns:
+//   - manifold.LiteMarket: The market.
+func (g *Generator) Market(creator manifold.User) manifold.LiteMarket {
+	question := fmt.Sprintf("Will %s %s by the end of the month?",
+		subjects[g.rand.Intn(len(subjects))], events[g.rand.Intn(len(events))])
+	slug := slugify(question)
+	created := g.after(creator.CreatedTime, 14*24*time.Hour)
+	closes := created + int64(7+g.rand.Intn(84))*24*time.Hour.Milliseconds()
+	liquidity := float64(50 + 50*g.rand.Intn(20))
+	p := 0.5
+	prob := 0.5
+
+	return manifold.LiteMarket{
+		ID:                g.id(),
+		CreatorID:         creator.ID,
+		CreatorUsername:   creator.Username,
+		CreatorName:       creator.Name,
+		CreatedTime:       created,
+		CloseTime:         &closes,
+		Question:          question,
+		Slug:              slug,
+		URL:               fmt.Sprintf("https://manifold.markets/%s/%s", creator.Username, slug),
+		OutcomeType:       "BINARY",
+		Mechanism:         "cpmm-1",
+		Pool:              map[string]float64{"YES": liquidity, "NO": liquidity},
+		Probability:       &prob,
+		P:                 &p,
+		TotalLiquidity:    &liquidity,
+		UniqueBettorCount: 0,
+		LastUpdatedTime:   &created,
+	}
+}
+
+// Bets generates bets by the given users on a market, between its creation and close times, and applies them to the
+// market's pool, probability, volume, bettor count and update times. Bets lean towards an outcome picked for the
+// market, so its probability drifts the way a real market's would. Only users created by the time of a bet place it,
+// and bets no user could have placed yet are left out.
+//
+// Parameters:
+//   - market: A pointer to the binary CPMM market, which is updated in place. Required.
+//   - users: The users placing the bets. Required.
+//   - n: The number of bets. Required.
+//
+// Returns:
+//   - []manifold.Bet: The bets, ordered by creation time. There are fewer than n if some were left out.
+func (g *Generator) Bets(market *manifold.LiteMarket, users []manifold.User, n int) []manifold.Bet {
+	times := g.times(market, n)
+	lean := g.rand.Float64()
+	bettors := make(map[string]bool)
+	bets := make([]manifold.Bet, 0, n)
+
+	for _, created := range times {
+		user, ok := g.userAt(users, created)
+		if !ok {
+			continue
+		}
+
+		outcome := "NO"
+		if g.rand.Float64() < lean {
+			outcome = "YES"
+		}
+		amount := float64(1 + g.rand.Intn(100))
+
+		yes, no := market.Pool["YES"], market.Pool["NO"]
+		before := no / (yes + no)
+
+		// Constant product market maker with p = 0.5: the amount buys one share of each outcome, and the shares of
+		// the other outcome are added to the pool until the product is restored.
+		var shares float64
+		k := yes * no
+		if outcome == "YES" {
+			no += amount
+			shares = amount + yes - k/no
+			yes = k / no
+		} else {
+			yes += amount
+			shares = amount + no - k/yes
+			no = k / yes
+		}
+		after := no / (yes + no)
+
+		market.Pool = map[string]float64{"YES": yes, "NO": no}
+		market.Probability = &after
+		market.Volume += amount
+		bettors[user.ID] = true
+		market.UniqueBettorCount = len(bettors)
+		market.LastBetTime = &created
+		market.LastUpdatedTime = &created
+
+		bets = append(bets, manifold.Bet{
+			ID:          g.id(),
+			UserID:      user.ID,
+			ContractID:  market.ID,
+			CreatedTime: created,
+			Amount:      amount,
+			Outcome:     outcome,
+			Shares:      shares,
+			ProbBefore:  before,
+			ProbAfter:   after,
+		})
+	}
+
+	return bets
+}
+
+// Comments generates comments by the given users on a market, between its creation and close times. Only users
+// created by the time of a comment post it, and comments no user could have posted yet are left out.
+//
+// Parameters:
+//   - market: The market. Required.
+//   - users: The users posting the comments. Required.
+//   - n: The number of comments. Required.
+//
+// Returns:
+//   - []manifold.Comment: The comments, ordered by creation time. There are fewer than n if some were left out.
+func (g *Generator) Comments(market manifold.LiteMarket, users []manifold.User, n int) []manifold.Comment {
+	times := g.times(&market, n)
+	comments := make([]manifold.Comment, 0, n)
+
+	for _, created := range times {
+		user, ok := g.userAt(users, created)
+		if !ok {
+			continue
+		}
+		text := remarks[g.rand.Intn(len(remarks))]
+
+		comment := manifold.Comment{
+			ID:           g.id(),
+			ContractID:   market.ID,
+			UserID:       user.ID,
+			Content:      tiptap(text),
+			CreatedTime:  created,
+			UserName:     user.Name,
+			UserUsername: user.Username,
+			Visibility:   "public",
+		}
+
+		// Some comments reply to an earlier one.
+		if len(comments) > 0 && g.rand.Intn(4) == 0 {
+			reply := comments[g.rand.Intn(len(comments))].ID
+			comment.ReplyToCommentID = &reply
+		}
+
+		comments = append(comments, comment)
+	}
+
+	return comments
+}
+
+// userAt picks a random user among those created by a timestamp.
+func (g *Generator) userAt(users []manifold.User, t int64) (manifold.User, bool) {
+	var eligible []manifold.User
+	for _, user := range users {
+		if user.CreatedTime <= t {
+			eligible = append(eligible, user)
+		}
+	}
+
+	if len(eligible) == 0 {
+		return manifold.User{}, false
+	}
+
+	return eligible[g.rand.Intn(len(eligible))], true
+}
+
+// id generates a random ID.
+func (g *Generator) id() string {
+	b := make([]byte, 12)
+	for i := range b {
+		b[i] = idAlphabet[g.rand.Intn(len(idAlphabet))]
+	}
+
+	return string(b)
+}
+
+// after returns a random timestamp within span after a timestamp.
+func (g *Generator) after(t int64, span time.Duration) int64 {
+	return t + g.rand.Int63n(span.Milliseconds())
+}
+
+// times returns n sorted random timestamps between the creation and close times of a market.
+func (g *Generator) times(market *manifold.LiteMarket, n int) []int64 {
+	end := market.CreatedTime + 7*24*time.Hour.Milliseconds()
+	if market.CloseTime != nil {
+		end = *market.CloseTime
+	}
+
+	times := make([]int64, n)
+	for i := range times {
+		times[i] = g.after(market.CreatedTime, time.Duration(end-market.CreatedTime)*time.Millisecond)
+	}
+	sort.Slice(times, func(i, j int) bool { return times[i] < times[j] })
+
+	return times
+}
+
+// slugify turns a question into a URL-friendly slug.
+func slugify(question string) string {
+	words := strings.FieldsFunc(strings.ToLower(question), func(r rune) bool {
+		return !(r >= 'a' && r <= 'z' || r >= '0' && r <= '9')
+	})
+
+	return strings.Join(words, "-")
+}
+
+// tiptap wraps text in a TipTap document with a single paragraph.
+func tiptap(text string) json.RawMessage {
+	doc := map[string]any{
+		"type": "doc",
+		"content": []any{map[string]any{
+			"type":    "paragraph",
+			"content": []any{map[string]any{"type": "text", "text": text}},
+		}},
+	}
+
+	content, _ := json.Marshal(doc)
+	return content
+}
@@ -0,0 +1,120 @@
+package manifold
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// FormatMana formats an amount of mana the way the site displays it, e.g. "Ṁ1,234" or "-Ṁ12.50".
+//
+// Parameters:
+//   - amount: The amount of mana. Required.
+//   - decimals: The number of decimal places to show. Required.
+//
+// Returns:
+//   - string: The formatted amount.
+func FormatMana(amount float64, decimals int) string {
+	sign := ""
+	if amount < 0 && math.Abs(amount) >= 0.5*math.Pow10(-decimals) {
+		sign = "-"
+	}
+
+	formatted := strconv.FormatFloat(math.Abs(amount), 'f', decimals, 64)
+	whole, fraction, _ := strings.Cut(formatted, ".")
+
+	var b strings.Builder
+	for i, digit := range whole {
+		if i > 0 && (len(whole)-i)%3 == 0 {
+			b.WriteByte(',')
+		}
+		b.WriteRune(digit)
+	}
+	if fraction != "" {
+		b.WriteString("." + fraction)
+	}
+
+	return sign + "Ṁ" + b.String()
+}
+
+// FormatProb formats a probability as a percentage, e.g. "53.2%".
+//
+// Parameters:
+//   - prob: The probability, between 0 and 1. Required.
+//   - decimals: The number of decimal places of the percentage to show. Required.
+//
+// Returns:
+//   - string: The formatted probability.
+func FormatProb(prob float64, decimals int) string {
+	return strconv.FormatFloat(prob*100, 'f', decimals, 64) + "%"
+}
+
+// FormatProbChange formats a change in probability in percentage points, always signed, e.g. "+5.3pp".
+//
+// Parameters:
+//   - delta: The change in probability, between -1 and 1. Required.
+//   - decimals: The number of decimal places of the percentage points to show. Required.
+//
+// Returns:
+//   - string: The formatted change.
+func FormatProbChange(delta float64, decimals int) string {
+	formatted := strconv.FormatFloat(math.Abs(delta*100), 'f', decimals, 64)
+
+	sign := "+"
+	if delta < 0 && strings.Trim(formatted, "0.") != "" {
+		sign = "-"
+	}
+
+	return sign + formatted + "pp"
+}
+
+// FormatRelativeTime formats a time relative to now, e.g. "5 minutes ago" or "in 3 days".
+//
+// Parameters:
+//   - t: The time to format. Required.
+//   - now: The time to format relative to. Required.
+//
+// Returns:
+//   - string: The formatted time.
+func FormatRelativeTime(t time.Time, now time.Time) string {
+	d := t.Sub(now)
+	future := d > 0
+	if !future {
+		d = -d
+	}
+
+	if d < time.Minute {
+		return "just now"
+	}
+
+	units := []struct {
+		name string
+		size time.Duration
+	}{
+		{"year", 365 * 24 * time.Hour},
+		{"month", 30 * 24 * time.Hour},
+		{"week", 7 * 24 * time.Hour},
+		{"day", 24 * time.Hour},
+		{"hour", time.Hour},
+		{"minute", time.Minute},
+	}
+
+	var text string
+	for _, unit := range units {
+		if d >= unit.size {
+			n := int(d / unit.size)
+			text = fmt.Sprintf("%d %s", n, unit.name)
+			if n != 1 {
+				text += "s"
+			}
+			break
+		}
+	}
+
+	if future {
+		return "in " + text
+	}
+	return text + " ago"
+}
@@ -0,0 +1,111 @@
+package manifold
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// TextPurpose identifies the point at which a TextGenerator is invoked.
+type TextPurpose string
+
+const (
+	PurposeMarketDescription TextPurpose = "MARKET_DESCRIPTION" // Draft the description of a market from its question
+	PurposeCommentReply      TextPurpose = "COMMENT_REPLY"      // Draft a reply to a comment on a market
+)
+
+// TextGenerator generates text for a purpose from a prompt, such as a language model. The library bundles no
+// implementation, so that bots can plug in any provider without the library depending on it.
+type TextGenerator interface {
+	Generate(purpose TextPurpose, prompt string) (string, error)
+}
+
+// TextGeneratorFunc adapts a function to the TextGenerator interface.
+type TextGeneratorFunc func(purpose TextPurpose, prompt string) (string, error)
+
+// Generate calls f.
+func (f TextGeneratorFunc) Generate(purpose TextPurpose, prompt string) (string, error) {
+	return f(purpose, prompt)
+}
+
+// DraftDescription asks a generator to draft the description of a market from its question. The draft is checked
+// against the length limit and passed through the client's content filter, and is not posted.
+//
+// Parameters:
+//   - generator: The text generator. Required.
+//   - question: The question of the market. Required.
+//   - maxLength: The maximum length of the draft, in characters. Required.
+//
+// Returns:
+//   - string: The draft description.
+//   - error: ErrorDraftRejected or ErrorContentBlocked if the draft fails a guardrail, or an error from the generator.
+func (c *Client) DraftDescription(generator TextGenerator, question string, maxLength int) (string, error) {
+	prompt := fmt.Sprintf("Write a description with clear resolution criteria for the prediction market %q.", question)
+
+	draft, err := c.draft(generator, PurposeMarketDescription, prompt, maxLength)
+	if err != nil {
+		return "", fmt.Errorf("DraftDescription: %w", err)
+	}
+
+	return draft, nil
+}
+
+// DraftReply asks a generator to draft a reply to a comment on a market, such as one mentioning the bot. The draft
+// is checked against the length limit and passed through the client's content filter, and is not posted.
+//
+// Parameters:
+//   - generator: The text generator. Required.
+//   - market: The market the comment is on. Required.
+//   - comment: The comment to reply to. Required.
+//   - maxLength: The maximum length of the draft, in characters. Required.
+//
+// Returns:
+//   - string: The draft reply.
+//   - error: ErrorDraftRejected or ErrorContentBlocked if the draft fails a guardrail, or an error from the generator.
+func (c *Client) DraftReply(generator TextGenerator, market *LiteMarket, comment *Comment, maxLength int) (string, error) {
+	text, err := PlainText(comment.Content)
+	if err != nil {
+		return "", fmt.Errorf("DraftReply: %w", err)
+	}
+
+	prompt := fmt.Sprintf("On the prediction market %q, currently at %s, %s commented:\n\n%s\n\nWrite a short reply.",
+		market.Question, marketState(market), comment.UserName, text)
+
+	draft, err := c.draft(generator, PurposeCommentReply, prompt, maxLength)
+	if err != nil {
+		return "", fmt.Errorf("DraftReply: %w", err)
+	}
+
+	return draft, nil
+}
+
+// draft generates text and applies the length and content guardrails to it.
+func (c *Client) draft(generator TextGenerator, purpose TextPurpose, prompt string, maxLength int) (string, error) {
+	draft, err := generator.Generate(purpose, prompt)
+	if err != nil {
+		return "", err
+	}
+
+	draft = strings.TrimSpace(draft)
+	if draft == "" {
+		return "", fmt.Errorf("%w: empty", ErrorDraftRejected)
+	}
+
+	if length := utf8.RuneCountInString(draft); length > maxLength {
+		return "", fmt.Errorf("%w: %d characters exceeds %d", ErrorDraftRejected, length, maxLength)
+	}
+
+	return c.filterContent(draft)
+}
+
+// marketState describes the current state of a market in a few words, for prompts.
+func marketState(market *LiteMarket) string {
+	switch {
+	case market.IsResolved && market.Resolution != nil:
+		return "resolved " + *market.Resolution
+	case market.Probability != nil:
+		return FormatProb(*market.Probability, 0)
+	default:
+		return fmt.Sprintf("%d traders", market.UniqueBettorCount)
+	}
+}
@@ -0,0 +1,282 @@
+package manifold
+
+import (
+	"fmt"
+	"math"
+	"sync"
+	"time"
+)
+
+// GuardRule describes when a held position in a binary market should be sold.
+// Probabilities refer to the outcome held, so a NO position is stopped out when the NO probability falls.
+type GuardRule struct {
+	ContractID string   // ID of the market the position is held in
+	Outcome    string   // Outcome held ("YES" or "NO")
+	StopLoss   *float64 // Sell when the probability of the held outcome falls to or below this value (optional)
+	TakeProfit *float64 // Sell when the probability of the held outcome rises to or above this value (optional)
+	Floor      *float64 // Refuse to stop out below this probability, to avoid selling into a gap (optional)
+
+	// MaxSlippage is the largest fall in the probability of the held outcome that the sale may cause. Only as many
+	// shares as stay within it are sold, and the rule stays registered for the rest (optional).
+	MaxSlippage *float64
+}
+
+// GuardEvent reports a rule being triggered by a PositionGuard.
+type GuardEvent struct {
+	Rule        GuardRule // The rule that was triggered
+	Probability float64   // Probability of the held outcome when the rule was triggered
+	Sale        *Bet      // The sale that was executed, or nil if it failed or no shares were held
+	Err         error     // The error that prevented the sale, if any. The rule stays registered and is retried
+}
+
+// PositionGuard sells held positions once they move past stop-loss or take-profit thresholds.
+// Rules are checked by polling the market probability, either through Check or Run.
+type PositionGuard struct {
+	client    *Client
+	onTrigger func(GuardEvent)
+
+	mu     sync.Mutex
+	rules  map[string]GuardRule
+	userID string
+}
+
+// NewPositionGuard creates a new position guard.
+//
+// Parameters:
+//   - client: The client used to poll markets and place sales. Required.
+//   - onTrigger: Called whenever a rule is triggered, with the result of the sale. Optional.
+//
+// Returns:
+//   - *PositionGuard: A pointer to the newly created position guard, with no rules registered.
+func NewPositionGuard(client *Client, onTrigger func(GuardEvent)) *PositionGuard {
+	return &PositionGuard{
+		client:    client,
+		onTrigger: onTrigger,
+		rules:     make(map[string]GuardRule),
+	}
+}
+
+// Register adds a rule to the guard, replacing any existing rule for the same market.
+//
+// Parameters:
+//   - rule: The rule to register. Required.
+//
+// Returns:
+//   - error: An error object if input validation fails.
+func (g *PositionGuard) Register(rule GuardRule) error {
+	if err := checkOneOf(rule.Outcome, "YES", "NO"); err != nil {
+		return fmt.Errorf("PositionGuard: Register(outcome): %w", err)
+	}
+
+	if rule.StopLoss == nil && rule.TakeProfit == nil {
+		return fmt.Errorf("PositionGuard: Register: rule needs a stop-loss or a take-profit")
+	}
+
+	if rule.StopLoss != nil && rule.TakeProfit != nil && *rule.StopLoss >= *rule.TakeProfit {
+		return fmt.Errorf("PositionGuard: Register: stop-loss must be below take-profit")
+	}
+
+	if rule.MaxSlippage != nil {
+		if err := checkInRange(*rule.MaxSlippage, 0, 1); err != nil {
+			return fmt.Errorf("PositionGuard: Register(maxSlippage): %w", err)
+		}
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.rules[rule.ContractID] = rule
+
+	return nil
+}
+
+// Unregister removes the rule for a market, if any.
+//
+// Parameters:
+//   - contractID: The ID of the market to stop guarding. Required.
+func (g *PositionGuard) Unregister(contractID string) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	delete(g.rules, contractID)
+}
+
+// Check polls every guarded market once and sells positions whose rules are triggered, reporting every attempt through
+// the trigger callback. A rule is removed once its whole position is sold, unless it was replaced in the meantime.
+// Failed and partial sales keep the rule, so they are retried on the next check.
+//
+// Returns:
+//   - error: An error object if a market could not be polled. Remaining markets are still checked.
+func (g *PositionGuard) Check() error {
+	g.mu.Lock()
+	rules := make([]GuardRule, 0, len(g.rules))
+	for _, rule := range g.rules {
+		rules = append(rules, rule)
+	}
+	g.mu.Unlock()
+
+	var firstErr error
+	for _, rule := range rules {
+		market, err := g.client.Market.Market(rule.ContractID)
+		if err != nil {
+			if firstErr == nil {
+				firstErr = fmt.Errorf("PositionGuard: Check: %w", err)
+			}
+			continue
+		}
+
+		if market.Probability == nil {
+			continue
+		}
+
+		prob := *market.Probability
+		if rule.Outcome == "NO" {
+			prob = 1 - prob
+		}
+
+		stopped := rule.StopLoss != nil && prob <= *rule.StopLoss && (rule.Floor == nil || prob >= *rule.Floor)
+		profited := rule.TakeProfit != nil && prob >= *rule.TakeProfit
+		if !stopped && !profited {
+			continue
+		}
+
+		sale, complete, err := g.sell(rule, market)
+		if err == nil && complete {
+			g.mu.Lock()
+			if current, ok := g.rules[rule.ContractID]; ok && current == rule {
+				delete(g.rules, rule.ContractID)
+			}
+			g.mu.Unlock()
+		}
+
+		if g.onTrigger != nil {
+			g.onTrigger(GuardEvent{Rule: rule, Probability: prob, Sale: sale, Err: err})
+		}
+	}
+
+	return firstErr
+}
+
+// sell sells the position of a triggered rule, limited to the shares within its slippage bound if it has one.
+// It reports whether the whole position was sold.
+func (g *PositionGuard) sell(rule GuardRule, market *FullMarket) (*Bet, bool, error) {
+	if rule.MaxSlippage == nil {
+		sale, err := g.client.Market.Sell(rule.ContractID, &rule.Outcome, nil, nil)
+		if err != nil {
+			return nil, false, fmt.Errorf("PositionGuard: %w", err)
+		}
+		return sale, true, nil
+	}
+
+	yes, no, p, err := cpmmPool(&market.LiteMarket)
+	if err != nil {
+		return nil, false, fmt.Errorf("PositionGuard: %w", err)
+	}
+
+	held, err := g.heldShares(rule)
+	if err != nil {
+		return nil, false, err
+	}
+	if held <= 0 {
+		return nil, true, nil
+	}
+
+	probOf := func(yes, no float64) float64 {
+		if rule.Outcome == "NO" {
+			return 1 - cpmmProb(yes, no, p)
+		}
+		return cpmmProb(yes, no, p)
+	}
+	floor := probOf(yes, no) - *rule.MaxSlippage
+	within := func(shares float64) bool {
+		_, newYes, newNo := cpmmSell(yes, no, p, rule.Outcome, shares)
+		return probOf(newYes, newNo) >= floor
+	}
+
+	if within(held) {
+		sale, err := g.client.Market.Sell(rule.ContractID, &rule.Outcome, nil, nil)
+		if err != nil {
+			return nil, false, fmt.Errorf("PositionGuard: %w", err)
+		}
+		return sale, true, nil
+	}
+
+	// The price impact grows with the shares sold, so search for the most shares within the bound.
+	low, high := 0.0, held
+	for i := 0; i < 50; i++ {
+		mid := (low + high) / 2
+		if within(mid) {
+			low = mid
+		} else {
+			high = mid
+		}
+	}
+
+	shares := math.Floor(low)
+	if shares < 1 {
+		return nil, false, fmt.Errorf("PositionGuard: %w: no shares can be sold within %.4f of the probability", ErrorSlippage, *rule.MaxSlippage)
+	}
+
+	sale, err := g.client.Market.Sell(rule.ContractID, &rule.Outcome, &shares, nil)
+	if err != nil {
+		return nil, false, fmt.Errorf("PositionGuard: %w", err)
+	}
+
+	return sale, false, nil
+}
+
+// heldShares returns the shares of the held outcome of a rule, looking up the authenticated user on first use.
+func (g *PositionGuard) heldShares(rule GuardRule) (float64, error) {
+	g.mu.Lock()
+	userID := g.userID
+	g.mu.Unlock()
+
+	if userID == "" {
+		me, err := g.client.User.Me()
+		if err != nil {
+			return 0, fmt.Errorf("PositionGuard: %w", err)
+		}
+		userID = me.ID
+
+		g.mu.Lock()
+		g.userID = userID
+		g.mu.Unlock()
+	}
+
+	positions, err := g.client.Market.userPositions(rule.ContractID, userID)
+	if err != nil {
+		return 0, fmt.Errorf("PositionGuard: %w", err)
+	}
+
+	var held float64
+	for _, position := range positions {
+		if position.AnswerID == nil {
+			held += position.TotalShares[rule.Outcome]
+		}
+	}
+
+	return held, nil
+}
+
+// Run calls Check at the given interval until stop is closed. Errors from Check are passed to onError.
+//
+// Parameters:
+//   - interval: The time between checks. Required.
+//   - stop: A channel that stops the guard when closed. Required.
+//   - onError: Called with every error returned by Check. Optional.
+func (g *PositionGuard) Run(interval time.Duration, stop <-chan struct{}, onError func(error)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := g.Check(); err != nil && onError != nil {
+			onError(err)
+		}
+
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
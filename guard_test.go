@@ -0,0 +1,96 @@
+package manifold
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestPositionGuardKeepsRuleAfterFailedSale(t *testing.T) {
+	failSale := true
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/market/m1":
+			w.Write([]byte(`{"id":"m1","probability":0.2}`))
+		case "/market/m1/sell":
+			if failSale {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			w.Write([]byte(`{"id":"sale","contractId":"m1"}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("key")
+	client.BaseURL = server.URL
+
+	var events []GuardEvent
+	guard := NewPositionGuard(client, func(event GuardEvent) { events = append(events, event) })
+	if err := guard.Register(GuardRule{ContractID: "m1", Outcome: "YES", StopLoss: ptr(0.3)}); err != nil {
+		t.Fatalf("Register() error = %v", err)
+	}
+
+	if err := guard.Check(); err != nil {
+		t.Fatalf("Check() error = %v", err)
+	}
+	if len(events) != 1 || events[0].Err == nil {
+		t.Fatalf("events = %+v, want one failed sale", events)
+	}
+	if _, ok := guard.rules["m1"]; !ok {
+		t.Fatal("rule removed after a failed sale")
+	}
+
+	failSale = false
+	if err := guard.Check(); err != nil {
+		t.Fatalf("Check() error = %v", err)
+	}
+	if len(events) != 2 || events[1].Err != nil || events[1].Sale == nil {
+		t.Fatalf("events = %+v, want a successful sale", events)
+	}
+	if _, ok := guard.rules["m1"]; ok {
+		t.Error("rule kept after a successful sale")
+	}
+}
+
+func TestPositionGuardBoundedSale(t *testing.T) {
+	var sold string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/market/m1":
+			w.Write([]byte(`{"id":"m1","mechanism":"cpmm-1","p":0.5,"pool":{"YES":100,"NO":100},"probability":0.5}`))
+		case "/me":
+			w.Write([]byte(`{"id":"u1"}`))
+		case "/market/m1/positions":
+			w.Write([]byte(`[{"contractId":"m1","userId":"u1","totalShares":{"YES":500}}]`))
+		case "/market/m1/sell":
+			var body map[string]string
+			decodeBody(t, r, &body)
+			sold = body["shares"]
+			w.Write([]byte(`{"id":"sale","contractId":"m1"}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("key")
+	client.BaseURL = server.URL
+
+	guard := NewPositionGuard(client, nil)
+	guard.Register(GuardRule{ContractID: "m1", Outcome: "YES", StopLoss: ptr(0.6), MaxSlippage: ptr(0.1)})
+
+	if err := guard.Check(); err != nil {
+		t.Fatalf("Check() error = %v", err)
+	}
+
+	// Selling about 40.8 YES shares moves a 100/100 pool from 0.5 to 0.4.
+	if sold != "40.000000" {
+		t.Errorf("sold %q shares, want 40", sold)
+	}
+	if _, ok := guard.rules["m1"]; !ok {
+		t.Error("rule removed after a partial sale")
+	}
+}
@@ -0,0 +1,140 @@
+package manifold
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+)
+
+// HeatWeights are the weights of the components of a heat score.
+type HeatWeights struct {
+	Volume     float64 // Weight of log(1 + volume)
+	NewBettors float64 // Weight of log(1 + new bettors)
+	Comments   float64 // Weight of log(1 + comments)
+	Movement   float64 // Weight of the absolute probability movement, in percentage points
+}
+
+// DefaultHeatWeights are weights that make each component contribute comparably for a typical active market.
+var DefaultHeatWeights = HeatWeights{Volume: 1, NewBettors: 2, Comments: 1.5, Movement: 0.1}
+
+// HeatInputs is the recent activity of a market that its heat score is computed from.
+type HeatInputs struct {
+	ContractID string  // ID of the market
+	Volume     float64 // Volume traded within the window
+	NewBettors int     // Number of users who bet on the market for the first time within the window
+	Comments   int     // Number of comments posted within the window
+	Movement   float64 // Absolute change in probability over the window, between 0 and 1
+}
+
+// HeatScore is the heat score of a market.
+type HeatScore struct {
+	HeatInputs
+
+	Score float64 // Weighted sum of the components
+}
+
+// Heat computes the heat score of a market from its recent activity. Counts are log-scaled so that a single
+// very large trade or comment thread does not dominate the score.
+//
+// Parameters:
+//   - inputs: The recent activity of the market. Required.
+//   - weights: The weights of the components, e.g. DefaultHeatWeights. Required.
+//
+// Returns:
+//   - HeatScore: The heat score of the market.
+func Heat(inputs HeatInputs, weights HeatWeights) HeatScore {
+	score := weights.Volume*math.Log1p(inputs.Volume) +
+		weights.NewBettors*math.Log1p(float64(inputs.NewBettors)) +
+		weights.Comments*math.Log1p(float64(inputs.Comments)) +
+		weights.Movement*math.Abs(inputs.Movement)*100
+
+	return HeatScore{HeatInputs: inputs, Score: score}
+}
+
+// RankByHeat computes the heat scores of several markets and orders them.
+//
+// Parameters:
+//   - inputs: The recent activity of every market. Required.
+//   - weights: The weights of the components, e.g. DefaultHeatWeights. Required.
+//
+// Returns:
+//   - []HeatScore: The heat scores, hottest first.
+func RankByHeat(inputs []HeatInputs, weights HeatWeights) []HeatScore {
+	scores := make([]HeatScore, len(inputs))
+	for i, in := range inputs {
+		scores[i] = Heat(in, weights)
+	}
+
+	sort.SliceStable(scores, func(i, j int) bool {
+		return scores[i].Score > scores[j].Score
+	})
+
+	return scores
+}
+
+// HeatInputs retrieves the activity of a binary market over a recent window, to compute its heat score.
+//
+// Parameters:
+//   - id: The ID of the market. Required.
+//   - window: The length of the recent window, e.g. 24 hours. Must be greater than zero. Required.
+//
+// Returns:
+//   - *HeatInputs: A pointer to the recent activity of the market.
+//   - error: An error object if a request fails or if input validation fails.
+func (s *MarketService) HeatInputs(id string, window time.Duration) (*HeatInputs, error) {
+	if window <= 0 {
+		return nil, fmt.Errorf("Market: HeatInputs(window): invalid value: %v, must be greater than 0", window)
+	}
+
+	bets, err := s.client.Bet.allBets(nil, &id, nil)
+	if err != nil {
+		return nil, fmt.Errorf("Market: HeatInputs: %w", err)
+	}
+
+	since := time.Now().Add(-window)
+	comments, err := s.client.Comment.Since(id, since)
+	if err != nil {
+		return nil, fmt.Errorf("Market: HeatInputs: %w", err)
+	}
+
+	inputs := &HeatInputs{ContractID: id, Comments: len(comments)}
+
+	// Bets are newest first, so walk them backwards to see each bettor's first bet first.
+	sinceMs := since.UnixMilli()
+	seen := make(map[string]bool)
+	var first, last *Bet
+	for i := len(bets) - 1; i >= 0; i-- {
+		bet := &bets[i]
+		if bet.IsRedemption {
+			continue
+		}
+
+		if bet.CreatedTime < sinceMs {
+			seen[bet.UserID] = true
+			continue
+		}
+
+		if first == nil {
+			first = bet
+		}
+		last = bet
+
+		if bet.Amount < 0 {
+			inputs.Volume -= bet.Amount
+		} else {
+			inputs.Volume += bet.Amount
+		}
+
+		if !seen[bet.UserID] {
+			seen[bet.UserID] = true
+			inputs.NewBettors++
+		}
+	}
+
+	if first != nil && first.AnswerID == nil {
+		inputs.Movement = math.Abs(last.ProbAfter - first.ProbBefore)
+	}
+
+	return inputs, nil
+}
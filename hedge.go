@@ -0,0 +1,140 @@
+package manifold
+
+import (
+	"fmt"
+	"math"
+)
+
+// HedgeSuggestion represents a trade that reduces the net exposure of a portfolio to a single event.
+type HedgeSuggestion struct {
+	ContractID   string  // ID of the market to trade in
+	Outcome      string  // Outcome to buy ("YES" or "NO")
+	Shares       float64 // Number of shares to buy to flatten the exposure
+	ExpectedCost float64 // Expected cost of the trade at the current probability, ignoring slippage
+}
+
+// SuggestHedges suggests trades that flatten the exposure of a portfolio across groups of correlated markets.
+// Every market in a group is assumed to resolve the same way, so YES shares in one market offset NO shares in another.
+// For each group with a net exposure, the cheapest market to buy the opposite side in is suggested.
+//
+// Parameters:
+//   - positions: The positions held by a single user. Positions on answers of multiple choice markets are ignored. Required.
+//   - groups: Sets of market IDs that are considered to represent the same event. Required.
+//   - probs: The current probability of every market in groups, keyed by market ID. Required.
+//
+// Returns:
+//   - []HedgeSuggestion: One suggestion per group with a net exposure.
+//   - error: An error object if the probability of a market in a group is missing.
+func SuggestHedges(positions []ContractMetric, groups [][]string, probs map[string]float64) ([]HedgeSuggestion, error) {
+	shares := make(map[string]map[string]float64, len(positions))
+	for _, position := range positions {
+		if position.AnswerID != nil {
+			continue
+		}
+
+		shares[position.ContractID] = position.TotalShares
+	}
+
+	suggestions := make([]HedgeSuggestion, 0, len(groups))
+	for _, group := range groups {
+		var exposure float64
+		for _, id := range group {
+			exposure += shares[id]["YES"] - shares[id]["NO"]
+		}
+
+		if math.Abs(exposure) < 1e-9 {
+			continue
+		}
+
+		outcome := "NO"
+		if exposure < 0 {
+			outcome = "YES"
+		}
+
+		var (
+			best      string
+			bestPrice = math.Inf(1)
+		)
+		for _, id := range group {
+			prob, ok := probs[id]
+			if !ok {
+				return nil, fmt.Errorf("SuggestHedges: missing probability for market %s", id)
+			}
+
+			price := prob
+			if outcome == "NO" {
+				price = 1 - prob
+			}
+
+			if price < bestPrice {
+				best, bestPrice = id, price
+			}
+		}
+
+		if best == "" {
+			continue
+		}
+
+		suggestions = append(suggestions, HedgeSuggestion{
+			ContractID:   best,
+			Outcome:      outcome,
+			Shares:       math.Abs(exposure),
+			ExpectedCost: math.Abs(exposure) * bestPrice,
+		})
+	}
+
+	return suggestions, nil
+}
+
+// Hedges fetches a user's positions and the current probabilities of the given markets, and suggests trades
+// that flatten the user's exposure across each group of correlated markets (see SuggestHedges).
+//
+// Parameters:
+//   - userID: The ID of the user whose positions are hedged. Required.
+//   - groups: Sets of binary market IDs that are considered to represent the same event. Required.
+//
+// Returns:
+//   - []HedgeSuggestion: One suggestion per group with a net exposure.
+//   - error: An error object if a request fails or if the response cannot be parsed.
+func (s *MarketService) Hedges(userID string, groups [][]string) ([]HedgeSuggestion, error) {
+	var (
+		positions []ContractMetric
+		probs     = make(map[string]float64)
+	)
+
+	for _, group := range groups {
+		for _, id := range group {
+			if _, ok := probs[id]; ok {
+				continue
+			}
+
+			market, err := s.Market(id)
+			if err != nil {
+				return nil, fmt.Errorf("Market: Hedges: %w", err)
+			}
+
+			if market.Probability == nil {
+				return nil, fmt.Errorf("Market: Hedges: market %s has no probability", id)
+			}
+			probs[id] = *market.Probability
+
+			marketPositions, err := s.Positions(id)
+			if err != nil {
+				return nil, fmt.Errorf("Market: Hedges: %w", err)
+			}
+
+			for _, position := range marketPositions {
+				if position.UserID == userID {
+					positions = append(positions, position)
+				}
+			}
+		}
+	}
+
+	suggestions, err := SuggestHedges(positions, groups, probs)
+	if err != nil {
+		return nil, fmt.Errorf("Market: Hedges: %w", err)
+	}
+
+	return suggestions, nil
+}
@@ -0,0 +1,16 @@
+package manifold
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+// decodeBody decodes the JSON body of a request received by a test server.
+func decodeBody(t *testing.T, r *http.Request, v any) {
+	t.Helper()
+
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		t.Fatalf("decoding request body: %v", err)
+	}
+}
@@ -0,0 +1,232 @@
+package manifold
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// HistoricPosition is a position as it stood at a point in the past.
+type HistoricPosition struct {
+	ContractID string             // ID of the market
+	AnswerID   *string            // ID of the answer, for multiple choice markets (optional)
+	Shares     map[string]float64 // Shares held per outcome
+	Invested   float64            // Net amount spent on the position
+	Value      float64            // Value of the position at the time, or its payout if already resolved
+	Resolved   bool               // Whether the market (or answer) had resolved by the time
+}
+
+// HistoricPortfolio is a portfolio reconstructed at a point in the past.
+type HistoricPortfolio struct {
+	Time      time.Time          // Time the portfolio was reconstructed at
+	Positions []HistoricPosition // Positions held, ordered by market and answer ID
+	Invested  float64            // Total net amount spent
+	Value     float64            // Total value
+}
+
+// historicResolution is the resolution of a market or answer, as needed to value a position.
+type historicResolution struct {
+	resolution     string
+	time           int64
+	resolutionProb *float64
+}
+
+// ReconstructPortfolio replays bets placed up to a point in time into the portfolio held at that time. Limit orders
+// only count the fills they had by then.
+// Positions in markets that had resolved by then are valued at their payout, and other positions at their probability then.
+// Cash balance is not included, as it cannot be derived from bets alone.
+//
+// Parameters:
+//   - bets: The bets placed by a single user, in any order. Required.
+//   - markets: The markets the bets were placed in, keyed by market ID. Required.
+//   - probs: The probability at the time, keyed by answer ID for multiple choice markets and by market ID otherwise. Required.
+//   - at: The time to reconstruct the portfolio at. Required.
+//
+// Returns:
+//   - *HistoricPortfolio: A pointer to the reconstructed portfolio.
+//   - error: An error object if the market or probability of an open position is missing.
+func ReconstructPortfolio(bets []Bet, markets map[string]*FullMarket, probs map[string]float64, at time.Time) (*HistoricPortfolio, error) {
+	atMs := at.UnixMilli()
+	positions := make(map[string]*HistoricPosition)
+
+	for _, bet := range bets {
+		if bet.CreatedTime > atMs {
+			continue
+		}
+
+		key := bet.ContractID
+		if bet.AnswerID != nil {
+			key = *bet.AnswerID
+		}
+
+		for _, f := range betFills(bet) {
+			if f.timestamp > atMs {
+				continue
+			}
+
+			position, ok := positions[key]
+			if !ok {
+				position = &HistoricPosition{ContractID: bet.ContractID, AnswerID: bet.AnswerID, Shares: make(map[string]float64)}
+				positions[key] = position
+			}
+
+			position.Shares[bet.Outcome] += f.shares
+			position.Invested += f.amount
+		}
+	}
+
+	portfolio := &HistoricPortfolio{Time: at, Positions: make([]HistoricPosition, 0, len(positions))}
+	for key, position := range positions {
+		market, ok := markets[position.ContractID]
+		if !ok {
+			return nil, fmt.Errorf("ReconstructPortfolio: missing market %s", position.ContractID)
+		}
+
+		resolution := marketResolution(market, position.AnswerID)
+		if resolution != nil && resolution.time <= atMs {
+			position.Resolved = true
+			position.Value = resolutionPayout(resolution, position)
+		} else {
+			prob, ok := probs[key]
+			if !ok {
+				return nil, fmt.Errorf("ReconstructPortfolio: missing probability for %s", key)
+			}
+			position.Value = position.Shares["YES"]*prob + position.Shares["NO"]*(1-prob)
+		}
+
+		portfolio.Invested += position.Invested
+		portfolio.Value += position.Value
+		portfolio.Positions = append(portfolio.Positions, *position)
+	}
+
+	sort.Slice(portfolio.Positions, func(i, j int) bool {
+		a, b := portfolio.Positions[i], portfolio.Positions[j]
+		if a.ContractID != b.ContractID {
+			return a.ContractID < b.ContractID
+		}
+		return a.AnswerID != nil && (b.AnswerID == nil || *a.AnswerID < *b.AnswerID)
+	})
+
+	return portfolio, nil
+}
+
+// marketResolution returns the resolution of a market, or of one of its answers, or nil if it has not resolved.
+func marketResolution(market *FullMarket, answerID *string) *historicResolution {
+	if answerID != nil && market.Answers != nil {
+		for _, answer := range *market.Answers {
+			if answer.ID == *answerID && answer.Resolution != nil && answer.ResolutionTime != nil {
+				return &historicResolution{*answer.Resolution, *answer.ResolutionTime, answer.ResolutionProbability}
+			}
+		}
+	}
+
+	if market.IsResolved && market.Resolution != nil && market.ResolutionTime != nil {
+		return &historicResolution{*market.Resolution, *market.ResolutionTime, market.ResolutionProbability}
+	}
+
+	return nil
+}
+
+// resolutionPayout returns the payout of a position in a resolved market or answer.
+func resolutionPayout(resolution *historicResolution, position *HistoricPosition) float64 {
+	switch resolution.resolution {
+	case "YES":
+		return position.Shares["YES"]
+	case "NO":
+		return position.Shares["NO"]
+	case "MKT":
+		if resolution.resolutionProb != nil {
+			return position.Shares["YES"]*(*resolution.resolutionProb) + position.Shares["NO"]*(1-*resolution.resolutionProb)
+		}
+	case "CANCEL":
+		return position.Invested
+	}
+
+	return 0
+}
+
+// PortfolioAt reconstructs the portfolio a user held at a point in the past by replaying their bets and the
+// resolutions of the markets they bet on (see ReconstructPortfolio).
+//
+// Parameters:
+//   - userID: The ID of the user. Required.
+//   - at: The time to reconstruct the portfolio at. Required.
+//
+// Returns:
+//   - *HistoricPortfolio: A pointer to the reconstructed portfolio.
+//   - error: An error object if a request fails or if the response cannot be parsed.
+func (s *UserService) PortfolioAt(userID string, at time.Time) (*HistoricPortfolio, error) {
+	bets, err := s.client.Bet.allBets(&userID, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("User: PortfolioAt: %w", err)
+	}
+
+	markets := make(map[string]*FullMarket)
+	probs, err := s.client.Bet.probabilitiesAt(bets, markets, at)
+	if err != nil {
+		return nil, fmt.Errorf("User: PortfolioAt: %w", err)
+	}
+
+	portfolio, err := ReconstructPortfolio(bets, markets, probs, at)
+	if err != nil {
+		return nil, fmt.Errorf("User: %w", err)
+	}
+
+	return portfolio, nil
+}
+
+// probabilitiesAt fetches the probability at a point in time of every market (or answer) traded in by bets placed up to
+// it, keyed by answer ID for multiple choice markets and by market ID otherwise. The markets are added to markets.
+func (s *BetService) probabilitiesAt(bets []Bet, markets map[string]*FullMarket, at time.Time) (map[string]float64, error) {
+	probs := make(map[string]float64)
+	histories := make(map[string][]Bet)
+	limit := 1
+
+	for _, bet := range bets {
+		if bet.CreatedTime > at.UnixMilli() {
+			continue
+		}
+
+		if _, ok := markets[bet.ContractID]; !ok {
+			market, err := s.client.Market.Market(bet.ContractID)
+			if err != nil {
+				return nil, err
+			}
+			markets[bet.ContractID] = market
+		}
+
+		key := bet.ContractID
+		if bet.AnswerID != nil {
+			key = *bet.AnswerID
+		}
+
+		if _, ok := probs[key]; ok {
+			continue
+		}
+
+		// The probability at the time is the one left by the last bet placed on the market (or answer) before it.
+		// Multiple choice markets need their full history, as the latest bet may be on another answer.
+		last, ok := histories[bet.ContractID]
+		if !ok {
+			var err error
+			if bet.AnswerID == nil {
+				last, err = s.Bets(nil, nil, &bet.ContractID, nil, &limit, nil, nil, &at, nil, nil, nil)
+			} else {
+				last, err = s.allBets(nil, &bet.ContractID, nil)
+			}
+			if err != nil {
+				return nil, err
+			}
+			histories[bet.ContractID] = last
+		}
+
+		for _, candidate := range last {
+			if candidate.CreatedTime <= at.UnixMilli() && equalPtr(candidate.AnswerID, bet.AnswerID) {
+				probs[key] = candidate.ProbAfter
+				break
+			}
+		}
+	}
+
+	return probs, nil
+}
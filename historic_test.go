@@ -0,0 +1,55 @@
+package manifold
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestReconstructPortfolio(t *testing.T) {
+	at := time.Date(2026, time.March, 1, 0, 0, 0, 0, time.UTC)
+	ms := func(d time.Duration) int64 { return at.Add(d).UnixMilli() }
+
+	resolvedAt := ms(-time.Hour)
+	resolved := &FullMarket{}
+	resolved.ID, resolved.IsResolved, resolved.Resolution, resolved.ResolutionTime = "r", true, ptr("YES"), &resolvedAt
+	open := &FullMarket{}
+	open.ID = "o"
+	markets := map[string]*FullMarket{"r": resolved, "o": open}
+
+	bets := []Bet{
+		// A limit order placed before the time and filled partly after it.
+		{ContractID: "o", Outcome: "YES", Amount: 10, Shares: 20, CreatedTime: ms(-2 * time.Hour), LimitProps: &LimitProps{Fills: []Fill{
+			{Amount: 4, Shares: 8, Timestamp: ms(-time.Hour)},
+			{Amount: 6, Shares: 12, Timestamp: ms(time.Hour)},
+		}}},
+		{ContractID: "o", Outcome: "NO", Amount: 3, Shares: 5, CreatedTime: ms(-time.Hour)},
+		{ContractID: "r", Outcome: "YES", Amount: 5, Shares: 10, CreatedTime: ms(-2 * time.Hour)},
+		// Placed after the time.
+		{ContractID: "o", Outcome: "YES", Amount: 50, Shares: 100, CreatedTime: ms(time.Minute)},
+	}
+
+	portfolio, err := ReconstructPortfolio(bets, markets, map[string]float64{"o": 0.5}, at)
+	if err != nil {
+		t.Fatalf("ReconstructPortfolio() error = %v", err)
+	}
+
+	if len(portfolio.Positions) != 2 {
+		t.Fatalf("positions = %+v, want 2", portfolio.Positions)
+	}
+
+	o, r := portfolio.Positions[0], portfolio.Positions[1]
+	if o.Shares["YES"] != 8 || o.Shares["NO"] != 5 || o.Invested != 7 || math.Abs(o.Value-6.5) > 1e-9 || o.Resolved {
+		t.Errorf("open position = %+v, want 8 YES and 5 NO for 7, worth 6.5", o)
+	}
+	if r.Shares["YES"] != 10 || r.Value != 10 || !r.Resolved {
+		t.Errorf("resolved position = %+v, want 10 YES paid out", r)
+	}
+	if portfolio.Invested != 12 || math.Abs(portfolio.Value-16.5) > 1e-9 {
+		t.Errorf("portfolio invested %v worth %v, want 12 worth 16.5", portfolio.Invested, portfolio.Value)
+	}
+
+	if _, err := ReconstructPortfolio(bets, markets, nil, at); err == nil {
+		t.Error("ReconstructPortfolio() without probabilities: error = nil, want an error")
+	}
+}
@@ -0,0 +1,152 @@
+package manifold
+
+import (
+	"fmt"
+	"sort"
+)
+
+// EstimateImpact estimates the result of buying an outcome in a binary CPMM market, taking into account both the pool
+// and the resting limit orders the purchase would match against. Fees are ignored.
+//
+// Parameters:
+//   - market: The current state of the market. Required.
+//   - resting: The open limit orders on the market, from any user. Required.
+//   - outcome: The outcome to buy ("YES" or "NO"). Required.
+//   - amount: The amount to spend. Must be greater than zero. Required.
+//
+// Returns:
+//   - *Quote: A pointer to the estimated result.
+//   - error: An error object if input validation fails or the market is not a binary CPMM market.
+func EstimateImpact(market *LiteMarket, resting []Bet, outcome string, amount float64) (*Quote, error) {
+	if err := checkOneOf(outcome, "YES", "NO"); err != nil {
+		return nil, fmt.Errorf("EstimateImpact(outcome): %w", err)
+	}
+
+	if amount <= 0 {
+		return nil, fmt.Errorf("EstimateImpact(amount): invalid value: %f, value must be >0", amount)
+	}
+
+	yes, no, p, err := cpmmPool(market)
+	if err != nil {
+		return nil, fmt.Errorf("EstimateImpact: %w", err)
+	}
+
+	quote := &Quote{Amount: amount, ProbBefore: cpmmProb(yes, no, p)}
+
+	// Buying YES pushes the probability up into resting NO orders, and buying NO pushes it down into resting YES orders.
+	var orders []Bet
+	for _, bet := range resting {
+		if bet.LimitProps == nil || bet.LimitProps.IsFilled || bet.LimitProps.IsCancelled || bet.Outcome == outcome {
+			continue
+		}
+
+		limit := bet.LimitProps.LimitProb
+		if (outcome == "YES" && limit > quote.ProbBefore) || (outcome == "NO" && limit < quote.ProbBefore) {
+			orders = append(orders, bet)
+		}
+	}
+
+	sort.Slice(orders, func(i, j int) bool {
+		if outcome == "YES" {
+			return orders[i].LimitProps.LimitProb < orders[j].LimitProps.LimitProb
+		}
+		return orders[i].LimitProps.LimitProb > orders[j].LimitProps.LimitProb
+	})
+
+	left := amount
+	for _, order := range orders {
+		limit := order.LimitProps.LimitProb
+
+		// Move the pool up to the limit of the order first.
+		toLimit := cpmmAmountToProb(yes, no, p, outcome, limit)
+		if toLimit > 0 {
+			if left <= toLimit {
+				break
+			}
+
+			var shares float64
+			shares, yes, no = cpmmBuy(yes, no, p, outcome, toLimit)
+			quote.Shares += shares
+			left -= toLimit
+		}
+
+		// Then match against the order at its limit, where both sides' prices add up to one.
+		price, counterPrice := limit, 1-limit
+		if outcome == "NO" {
+			price, counterPrice = 1-limit, limit
+		}
+
+		var filled float64
+		for _, fill := range order.LimitProps.Fills {
+			filled += fill.Amount
+		}
+
+		available := (order.LimitProps.OrderAmount - filled) / counterPrice
+		if left <= available*price {
+			shares := left / price
+			quote.Shares += shares
+			quote.LimitShares += shares
+			left = 0
+			break
+		}
+
+		quote.Shares += available
+		quote.LimitShares += available
+		left -= available * price
+	}
+
+	if left > 0 {
+		var shares float64
+		shares, yes, no = cpmmBuy(yes, no, p, outcome, left)
+		quote.Shares += shares
+	}
+
+	quote.AveragePrice = amount / quote.Shares
+	quote.ProbAfter = cpmmProb(yes, no, p)
+
+	return quote, nil
+}
+
+// Impact fetches a binary market and its resting limit orders, and estimates the result of buying an outcome in it
+// (see EstimateImpact).
+//
+// Parameters:
+//   - id: The ID of the binary market. Required.
+//   - outcome: The outcome to buy ("YES" or "NO"). Required.
+//   - amount: The amount to spend. Must be greater than zero. Required.
+//
+// Returns:
+//   - *Quote: A pointer to the estimated result.
+//   - error: An error object if a request fails or if input validation fails.
+func (s *MarketService) Impact(id string, outcome string, amount float64) (*Quote, error) {
+	market, err := s.Market(id)
+	if err != nil {
+		return nil, fmt.Errorf("Market: Impact: %w", err)
+	}
+
+	var (
+		resting []Bet
+		before  *string
+		limit   = 1000
+		kinds   = "open-limit"
+	)
+	for {
+		page, err := s.client.Bet.Bets(nil, nil, &id, nil, &limit, before, nil, nil, nil, &kinds, nil)
+		if err != nil {
+			return nil, fmt.Errorf("Market: Impact: %w", err)
+		}
+
+		resting = append(resting, page...)
+		if len(page) < limit {
+			break
+		}
+		before = &page[len(page)-1].ID
+	}
+
+	quote, err := EstimateImpact(&market.LiteMarket, resting, outcome, amount)
+	if err != nil {
+		return nil, fmt.Errorf("Market: %w", err)
+	}
+
+	return quote, nil
+}
@@ -0,0 +1,63 @@
+package manifold
+
+import (
+	"math"
+	"testing"
+)
+
+func TestEstimateImpact(t *testing.T) {
+	market := &LiteMarket{ID: "m", Mechanism: "cpmm-1", P: ptr(0.5), Pool: map[string]float64{"YES": 100, "NO": 100}}
+	limit := func(outcome string, prob float64, amount float64) Bet {
+		return Bet{Outcome: outcome, LimitProps: &LimitProps{LimitProb: prob, OrderAmount: amount}}
+	}
+
+	// Buying 30 of YES moves the pool to 55% and buys the rest from a NO order there at 0.55 per share.
+	toLimit := cpmmAmountToProb(100, 100, 0.5, "YES", 0.55)
+	poolShares, yes, no := cpmmBuy(100, 100, 0.5, "YES", toLimit)
+	orderShares := (30 - toLimit) / 0.55
+
+	// Once an order is used up, the rest of the amount goes to the pool: 9 of NO at 0.55 sells 20 shares for 11.
+	restShares, _, _ := cpmmBuy(yes, no, 0.5, "YES", 5)
+
+	tests := []struct {
+		name        string
+		resting     []Bet
+		outcome     string
+		amount      float64
+		wantShares  float64
+		limitShares float64
+		wantErr     bool
+	}{
+		// Without resting orders, the estimate is the pool's.
+		{"pool only", nil, "YES", 10, 10 + 100 - 100*100/110.0, 0, false},
+		{"pool then order", []Bet{limit("NO", 0.55, 20)}, "YES", 30, poolShares + orderShares, orderShares, false},
+		{"order used up", []Bet{limit("NO", 0.55, 9)}, "YES", toLimit + 16, poolShares + 20 + restShares, 20, false},
+		// Orders for the bought outcome, or on the wrong side of the probability, are ignored.
+		{"orders ignored", []Bet{limit("YES", 0.6, 20), limit("NO", 0.4, 20)}, "YES", 10, 10 + 100 - 100*100/110.0, 0, false},
+		// Without pool movement up to it, a YES order at 0.45 sells NO shares at 0.55.
+		{"NO buy into a YES order", []Bet{limit("YES", 0.45, 20)}, "NO", 30, poolShares + orderShares, orderShares, false},
+		{"invalid outcome", nil, "MAYBE", 10, 0, 0, true},
+		{"invalid amount", nil, "YES", 0, 0, 0, true},
+	}
+
+	for _, tt := range tests {
+		quote, err := EstimateImpact(market, tt.resting, tt.outcome, tt.amount)
+		if (err != nil) != tt.wantErr {
+			t.Fatalf("%s: EstimateImpact() error = %v, want error %v", tt.name, err, tt.wantErr)
+		}
+		if err != nil {
+			continue
+		}
+
+		if math.Abs(quote.Shares-tt.wantShares) > 1e-9 || math.Abs(quote.LimitShares-tt.limitShares) > 1e-9 {
+			t.Errorf("%s: shares %v (%v from orders), want %v (%v)", tt.name, quote.Shares, quote.LimitShares, tt.wantShares, tt.limitShares)
+		}
+		if quote.ProbBefore != 0.5 {
+			t.Errorf("%s: ProbBefore = %v, want 0.5", tt.name, quote.ProbBefore)
+		}
+	}
+
+	if _, err := EstimateImpact(&LiteMarket{ID: "dpm", Mechanism: "dpm-2"}, nil, "YES", 10); err == nil {
+		t.Error("EstimateImpact() on a non-CPMM market: error = nil, want an error")
+	}
+}
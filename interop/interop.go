@@ -0,0 +1,99 @@
+// Package interop compares Manifold markets against forecasts for comparable questions on other platforms, such as
+// Polymarket and Metaculus.
+package interop
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/e74000/manifold"
+)
+
+// Forecast is the probability of a binary question on a forecasting platform, in a platform-independent form.
+type Forecast struct {
+	Platform    string    // Name of the platform, e.g. "manifold", "polymarket" or "metaculus"
+	ID          string    // Identifier of the question on the platform
+	Question    string    // Text of the question
+	URL         string    // URL of the question, if known
+	Probability float64   // Probability of the question resolving YES
+	FetchedAt   time.Time // Time the forecast was fetched
+}
+
+// Source fetches forecasts from a single platform.
+type Source interface {
+	// Platform returns the name of the platform the source fetches from.
+	Platform() string
+
+	// Forecast fetches the current forecast for the question with the given platform-specific identifier.
+	Forecast(id string) (*Forecast, error)
+}
+
+// Divergence is the difference between the Manifold forecast and another platform's forecast for the same question.
+type Divergence struct {
+	Manifold *Forecast // Forecast on Manifold
+	Other    *Forecast // Forecast on the other platform
+	Delta    float64   // Manifold probability minus the other platform's probability
+}
+
+// Compare fetches forecasts for the same question from two sources and computes their divergence.
+//
+// Parameters:
+//   - manifold: The source for the Manifold market. Required.
+//   - manifoldID: The identifier of the question on Manifold. Required.
+//   - other: The source for the other platform. Required.
+//   - otherID: The identifier of the question on the other platform. Required.
+//
+// Returns:
+//   - *Divergence: A pointer to the divergence between both forecasts.
+//   - error: An error object if either forecast cannot be fetched.
+func Compare(manifold Source, manifoldID string, other Source, otherID string) (*Divergence, error) {
+	a, err := manifold.Forecast(manifoldID)
+	if err != nil {
+		return nil, fmt.Errorf("interop: Compare(%s): %w", manifold.Platform(), err)
+	}
+
+	b, err := other.Forecast(otherID)
+	if err != nil {
+		return nil, fmt.Errorf("interop: Compare(%s): %w", other.Platform(), err)
+	}
+
+	return &Divergence{Manifold: a, Other: b, Delta: a.Probability - b.Probability}, nil
+}
+
+// ManifoldSource fetches forecasts from Manifold binary markets, identified by market ID.
+type ManifoldSource struct {
+	Client *manifold.Client // Client used to fetch markets
+}
+
+// Platform returns "manifold".
+func (s *ManifoldSource) Platform() string {
+	return "manifold"
+}
+
+// Forecast fetches the current probability of a Manifold binary market.
+//
+// Parameters:
+//   - id: The ID of the market. Required.
+//
+// Returns:
+//   - *Forecast: A pointer to the forecast.
+//   - error: An error object if the request fails or if the market has no probability.
+func (s *ManifoldSource) Forecast(id string) (*Forecast, error) {
+	market, err := s.Client.Market.Market(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if market.Probability == nil {
+		return nil, fmt.Errorf("market %s has no probability", id)
+	}
+
+	return &Forecast{
+		Platform:    s.Platform(),
+		ID:          market.ID,
+		Question:    market.Question,
+		URL:         market.URL,
+		Probability: *market.Probability,
+		FetchedAt:   time.Now(),
+	}, nil
+}
@@ -0,0 +1,110 @@
+package interop
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// MetaculusSource fetches forecasts from Metaculus binary questions through the public API, identified by post ID.
+// The forecast is the community prediction, which is only available once Metaculus reveals it.
+type MetaculusSource struct {
+	BaseURL    string       // Base URL of the API. Defaults to https://www.metaculus.com/api if empty.
+	Token      string       // API token, sent as an Authorization header (optional)
+	HTTPClient *http.Client // HTTP client used to perform requests. Defaults to http.DefaultClient if nil.
+}
+
+// metaculusPost is the subset of a Metaculus post used to build a forecast.
+type metaculusPost struct {
+	ID       int    `json:"id"`
+	Title    string `json:"title"`
+	Question *struct {
+		Type         string `json:"type"`
+		Aggregations struct {
+			RecencyWeighted struct {
+				Latest *struct {
+					Centers []float64 `json:"centers"` // Median of the community prediction
+				} `json:"latest"`
+			} `json:"recency_weighted"`
+		} `json:"aggregations"`
+	} `json:"question"`
+}
+
+// Platform returns "metaculus".
+func (s *MetaculusSource) Platform() string {
+	return "metaculus"
+}
+
+// Forecast fetches the current community prediction of a Metaculus binary question.
+//
+// Parameters:
+//   - id: The numeric ID of the post holding the question. Required.
+//
+// Returns:
+//   - *Forecast: A pointer to the forecast.
+//   - error: An error object if the ID is not numeric, the request fails, the post is not a single binary question, or its community prediction is hidden.
+func (s *MetaculusSource) Forecast(id string) (*Forecast, error) {
+	postID, err := strconv.Atoi(id)
+	if err != nil || postID <= 0 {
+		return nil, fmt.Errorf("invalid post ID %q", id)
+	}
+
+	baseURL := s.BaseURL
+	if baseURL == "" {
+		baseURL = "https://www.metaculus.com/api"
+	}
+
+	httpClient := s.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/posts/%d/", baseURL, postID), nil)
+	if err != nil {
+		return nil, err
+	}
+	if s.Token != "" {
+		req.Header.Set("Authorization", "Token "+s.Token)
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+
+	var post metaculusPost
+	if err := json.Unmarshal(body, &post); err != nil {
+		return nil, err
+	}
+
+	if post.Question == nil || post.Question.Type != "binary" {
+		return nil, fmt.Errorf("post %s is not a binary question", id)
+	}
+
+	latest := post.Question.Aggregations.RecencyWeighted.Latest
+	if latest == nil || len(latest.Centers) == 0 {
+		return nil, fmt.Errorf("post %s has no community prediction", id)
+	}
+
+	return &Forecast{
+		Platform:    s.Platform(),
+		ID:          fmt.Sprint(post.ID),
+		Question:    post.Title,
+		URL:         fmt.Sprintf("https://www.metaculus.com/questions/%d/", post.ID),
+		Probability: latest.Centers[0],
+		FetchedAt:   time.Now(),
+	}, nil
+}
@@ -0,0 +1,108 @@
+package interop
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// PolymarketSource fetches forecasts from Polymarket binary markets through the public Gamma API, identified by market slug.
+type PolymarketSource struct {
+	BaseURL    string       // Base URL of the Gamma API. Defaults to https://gamma-api.polymarket.com if empty.
+	HTTPClient *http.Client // HTTP client used to perform requests. Defaults to http.DefaultClient if nil.
+}
+
+// polymarketMarket is the subset of a Gamma API market used to build a forecast.
+type polymarketMarket struct {
+	ID            string `json:"id"`
+	Question      string `json:"question"`
+	Slug          string `json:"slug"`
+	Outcomes      string `json:"outcomes"`      // JSON-encoded list of outcome names
+	OutcomePrices string `json:"outcomePrices"` // JSON-encoded list of outcome prices, as strings
+}
+
+// Platform returns "polymarket".
+func (s *PolymarketSource) Platform() string {
+	return "polymarket"
+}
+
+// Forecast fetches the current price of the "Yes" outcome of a Polymarket market.
+//
+// Parameters:
+//   - slug: The slug of the market. Required.
+//
+// Returns:
+//   - *Forecast: A pointer to the forecast.
+//   - error: An error object if the request fails, the market cannot be found, or it has no "Yes" outcome.
+func (s *PolymarketSource) Forecast(slug string) (*Forecast, error) {
+	baseURL := s.BaseURL
+	if baseURL == "" {
+		baseURL = "https://gamma-api.polymarket.com"
+	}
+
+	httpClient := s.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	resp, err := httpClient.Get(fmt.Sprintf("%s/markets?slug=%s", baseURL, url.QueryEscape(slug)))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+
+	markets := make([]polymarketMarket, 0)
+	if err := json.Unmarshal(body, &markets); err != nil {
+		return nil, err
+	}
+
+	if len(markets) == 0 {
+		return nil, fmt.Errorf("market %s not found", slug)
+	}
+	market := markets[0]
+
+	var outcomes, prices []string
+	if err := json.Unmarshal([]byte(market.Outcomes), &outcomes); err != nil {
+		return nil, fmt.Errorf("parsing outcomes: %w", err)
+	}
+
+	if err := json.Unmarshal([]byte(market.OutcomePrices), &prices); err != nil {
+		return nil, fmt.Errorf("parsing outcome prices: %w", err)
+	}
+
+	for i, outcome := range outcomes {
+		if !strings.EqualFold(outcome, "yes") || i >= len(prices) {
+			continue
+		}
+
+		prob, err := strconv.ParseFloat(prices[i], 64)
+		if err != nil {
+			return nil, fmt.Errorf("parsing outcome price: %w", err)
+		}
+
+		return &Forecast{
+			Platform:    s.Platform(),
+			ID:          market.Slug,
+			Question:    market.Question,
+			URL:         "https://polymarket.com/market/" + market.Slug,
+			Probability: prob,
+			FetchedAt:   time.Now(),
+		}, nil
+	}
+
+	return nil, fmt.Errorf("market %s has no Yes outcome", slug)
+}
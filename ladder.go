@@ -0,0 +1,221 @@
+package manifold
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// LadderRung is a single market of a DateLadder.
+type LadderRung struct {
+	Deadline   time.Time `json:"deadline"`   // Deadline the market asks about, which is also its close time
+	ContractID string    `json:"contractId"` // ID of the market
+}
+
+// DateLadder is a family of binary markets that differ only by deadline, such as "by March", "by June", and
+// "by December" markets on the same event. It is serializable to JSON, so it can be stored and maintained over time.
+type DateLadder struct {
+	Template string       `json:"template"` // Question template, with {deadline} replaced by the formatted deadline
+	Layout   string       `json:"layout"`   // Time layout used to format deadlines in questions
+	Rungs    []LadderRung `json:"rungs"`    // Markets of the ladder, in order of deadline
+}
+
+// CDFPoint is a point of the implied distribution of when an event happens.
+type CDFPoint struct {
+	Deadline    time.Time // Deadline of the rung
+	Raw         float64   // Probability of the rung's market
+	Probability float64   // Cumulative probability that the event happens by the deadline, made non-decreasing
+	Mass        float64   // Probability that the event happens between the previous deadline and this one
+}
+
+// CreateLadder creates a binary market for each deadline from a question template, closing at the deadline,
+// and adds every market to a group so that they stay tagged together.
+//
+// Parameters:
+//   - template: The question template, containing {deadline}, e.g. "Will X happen by {deadline}?". Required.
+//   - layout: The time layout used to format deadlines, e.g. "January 2, 2006". Required.
+//   - deadlines: The deadlines, in any order. Must be in the future. Required.
+//   - initialProb: The initial probability (between 1 and 99) of every market. Required.
+//   - description: A description shared by every market. Optional.
+//   - groupID: The ID of the group to add every market to. Optional.
+//
+// Returns:
+//   - *DateLadder: A pointer to the ladder. On failure, the rungs created before it.
+//   - error: An error object if input validation or a request fails.
+func (s *MarketService) CreateLadder(template string, layout string, deadlines []time.Time, initialProb int, description *string, groupID *string) (*DateLadder, error) {
+	if !strings.Contains(template, "{deadline}") {
+		return nil, fmt.Errorf("Market: CreateLadder(template): must contain {deadline}")
+	}
+
+	sorted := append([]time.Time(nil), deadlines...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Before(sorted[j])
+	})
+
+	ladder := &DateLadder{Template: template, Layout: layout}
+	for _, deadline := range sorted {
+		if err := s.AddRung(ladder, deadline, initialProb, description, groupID); err != nil {
+			return ladder, fmt.Errorf("Market: CreateLadder: %w", err)
+		}
+	}
+
+	return ladder, nil
+}
+
+// AddRung extends a ladder with a market for a new deadline, keeping its rungs in order of deadline.
+//
+// Parameters:
+//   - ladder: The ladder to extend. Required.
+//   - deadline: The deadline of the new market. Must be in the future. Required.
+//   - initialProb: The initial probability (between 1 and 99) of the market. Required.
+//   - description: A description of the market. Optional.
+//   - groupID: The ID of the group to add the market to. Optional.
+//
+// Returns:
+//   - error: An error object if a rung already exists for the deadline, or if a request fails.
+func (s *MarketService) AddRung(ladder *DateLadder, deadline time.Time, initialProb int, description *string, groupID *string) error {
+	for _, rung := range ladder.Rungs {
+		if rung.Deadline.Equal(deadline) {
+			return fmt.Errorf("Market: AddRung: ladder already has a rung for %s", deadline.Format(ladder.Layout))
+		}
+	}
+
+	question := strings.ReplaceAll(ladder.Template, "{deadline}", deadline.Format(ladder.Layout))
+	market, err := s.CreateBinary(question, initialProb, description, &deadline, nil, nil)
+	if err != nil {
+		return fmt.Errorf("Market: AddRung: %w", err)
+	}
+
+	ladder.Rungs = append(ladder.Rungs, LadderRung{Deadline: deadline, ContractID: market.ID})
+	sort.SliceStable(ladder.Rungs, func(i, j int) bool {
+		return ladder.Rungs[i].Deadline.Before(ladder.Rungs[j].Deadline)
+	})
+
+	if groupID != nil {
+		if err := s.Group(market.ID, *groupID, nil); err != nil {
+			return fmt.Errorf("Market: AddRung: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// TagLadder adds every market of a ladder to a group.
+//
+// Parameters:
+//   - ladder: The ladder to tag. Required.
+//   - groupID: The ID of the group. Required.
+//
+// Returns:
+//   - error: An error object if a request fails.
+func (s *MarketService) TagLadder(ladder *DateLadder, groupID string) error {
+	for _, rung := range ladder.Rungs {
+		if err := s.Group(rung.ContractID, groupID, nil); err != nil {
+			return fmt.Errorf("Market: TagLadder: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// Constraint returns the constraint that the probabilities of the ladder increase with the deadline.
+//
+// Parameters:
+//   - tolerance: The allowed decrease between consecutive rungs. Required.
+//
+// Returns:
+//   - MonotoneConstraint: The constraint, for use with CheckConstraints.
+func (l *DateLadder) Constraint(tolerance float64) MonotoneConstraint {
+	ids := make([]string, len(l.Rungs))
+	for i, rung := range l.Rungs {
+		ids[i] = rung.ContractID
+	}
+
+	return MonotoneConstraint{Members: ids, Tolerance: tolerance}
+}
+
+// CDF combines the probabilities of the ladder into the implied distribution of when the event happens.
+// Inconsistent rungs, where a later deadline has a lower probability, are pooled together so that the
+// cumulative probability never decreases.
+//
+// Parameters:
+//   - probs: The probability of every rung, keyed by market ID. Required.
+//
+// Returns:
+//   - []CDFPoint: The distribution at every deadline, in order.
+//   - error: An error object if the probability of a rung is missing.
+func (l *DateLadder) CDF(probs map[string]float64) ([]CDFPoint, error) {
+	points := make([]CDFPoint, len(l.Rungs))
+	for i, rung := range l.Rungs {
+		prob, ok := probs[rung.ContractID]
+		if !ok {
+			return nil, fmt.Errorf("DateLadder: CDF: missing probability for %s", rung.ContractID)
+		}
+		points[i] = CDFPoint{Deadline: rung.Deadline, Raw: prob}
+	}
+
+	// Pool adjacent violators: merge decreasing runs into blocks at their mean.
+	type block struct {
+		sum   float64
+		count int
+	}
+	var blocks []block
+	for _, point := range points {
+		blocks = append(blocks, block{point.Raw, 1})
+		for len(blocks) > 1 {
+			last, prev := blocks[len(blocks)-1], blocks[len(blocks)-2]
+			if prev.sum/float64(prev.count) <= last.sum/float64(last.count) {
+				break
+			}
+			blocks = append(blocks[:len(blocks)-2], block{prev.sum + last.sum, prev.count + last.count})
+		}
+	}
+
+	i := 0
+	for _, b := range blocks {
+		for j := 0; j < b.count; j++ {
+			points[i].Probability = b.sum / float64(b.count)
+			i++
+		}
+	}
+
+	var previous float64
+	for i := range points {
+		points[i].Mass = points[i].Probability - previous
+		previous = points[i].Probability
+	}
+
+	return points, nil
+}
+
+// LadderCDF fetches the probabilities of a ladder's markets and combines them into the implied distribution of
+// when the event happens (see DateLadder.CDF).
+//
+// Parameters:
+//   - ladder: The ladder. Required.
+//
+// Returns:
+//   - []CDFPoint: The distribution at every deadline, in order.
+//   - error: An error object if a request fails or if a market has no probability.
+func (s *MarketService) LadderCDF(ladder *DateLadder) ([]CDFPoint, error) {
+	probs := make(map[string]float64, len(ladder.Rungs))
+	for _, rung := range ladder.Rungs {
+		market, err := s.Market(rung.ContractID)
+		if err != nil {
+			return nil, fmt.Errorf("Market: LadderCDF: %w", err)
+		}
+
+		if market.Probability == nil {
+			return nil, fmt.Errorf("Market: LadderCDF: market %s has no probability", rung.ContractID)
+		}
+		probs[rung.ContractID] = *market.Probability
+	}
+
+	points, err := ladder.CDF(probs)
+	if err != nil {
+		return nil, fmt.Errorf("Market: %w", err)
+	}
+
+	return points, nil
+}
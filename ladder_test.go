@@ -0,0 +1,53 @@
+package manifold
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestDateLadderCDF(t *testing.T) {
+	start := time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+	ladder := &DateLadder{Rungs: []LadderRung{
+		{Deadline: start, ContractID: "a"},
+		{Deadline: start.AddDate(0, 3, 0), ContractID: "b"},
+		{Deadline: start.AddDate(0, 6, 0), ContractID: "c"},
+		{Deadline: start.AddDate(0, 9, 0), ContractID: "d"},
+	}}
+
+	tests := []struct {
+		name    string
+		probs   map[string]float64
+		want    []float64
+		wantErr bool
+	}{
+		{"consistent", map[string]float64{"a": 0.1, "b": 0.2, "c": 0.4, "d": 0.8}, []float64{0.1, 0.2, 0.4, 0.8}, false},
+		{"one violation is pooled", map[string]float64{"a": 0.1, "b": 0.5, "c": 0.3, "d": 0.8}, []float64{0.1, 0.4, 0.4, 0.8}, false},
+		{"violations across blocks are pooled", map[string]float64{"a": 0.6, "b": 0.4, "c": 0.2, "d": 0.8}, []float64{0.4, 0.4, 0.4, 0.8}, false},
+		{"missing probability", map[string]float64{"a": 0.1, "b": 0.2, "c": 0.4}, nil, true},
+	}
+
+	for _, tt := range tests {
+		points, err := ladder.CDF(tt.probs)
+		if (err != nil) != tt.wantErr {
+			t.Fatalf("%s: CDF() error = %v, want error %v", tt.name, err, tt.wantErr)
+		}
+		if err != nil {
+			continue
+		}
+
+		var previous float64
+		for i, point := range points {
+			if !point.Deadline.Equal(ladder.Rungs[i].Deadline) || point.Raw != tt.probs[ladder.Rungs[i].ContractID] {
+				t.Errorf("%s: point %d = %+v, does not match its rung", tt.name, i, point)
+			}
+			if math.Abs(point.Probability-tt.want[i]) > 1e-9 {
+				t.Errorf("%s: point %d probability = %v, want %v", tt.name, i, point.Probability, tt.want[i])
+			}
+			if math.Abs(point.Mass-(tt.want[i]-previous)) > 1e-9 {
+				t.Errorf("%s: point %d mass = %v, want %v", tt.name, i, point.Mass, tt.want[i]-previous)
+			}
+			previous = tt.want[i]
+		}
+	}
+}
@@ -0,0 +1,50 @@
+package manifold
+
+import (
+	"log/slog"
+	"strings"
+	"time"
+)
+
+// WithLogger makes the client log every request at debug level, with its method, endpoint, status, latency and
+// retry attempt. The API key is redacted from anything logged.
+//
+// Parameters:
+//   - logger: The logger to log requests to. Required.
+//
+// Returns:
+//   - Option: An option to pass to NewClient.
+func WithLogger(logger *slog.Logger) Option {
+	return func(c *Client) {
+		c.logger = logger
+	}
+}
+
+// logRequest logs a request if a logger is set. A status of 0 means no response was received.
+func (c *Client) logRequest(method string, endpoint string, status int, latency time.Duration, attempt int, err error) {
+	if c.logger == nil {
+		return
+	}
+
+	args := []any{
+		slog.String("method", method),
+		slog.String("endpoint", c.redact(endpoint)),
+		slog.Int("status", status),
+		slog.Duration("latency", latency),
+		slog.Int("attempt", attempt),
+	}
+	if err != nil {
+		args = append(args, slog.String("error", c.redact(err.Error())))
+	}
+
+	c.logger.Debug("manifold request", args...)
+}
+
+// redact removes the API key from a string.
+func (c *Client) redact(s string) string {
+	if c.APIKey == "" {
+		return s
+	}
+
+	return strings.ReplaceAll(s, c.APIKey, "[REDACTED]")
+}
@@ -0,0 +1,106 @@
+package manifold
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+)
+
+// manaScale is the number of Mana units per mana.
+const manaScale = 1_000_000
+
+// Mana is a fixed-point amount of mana, stored in millionths. Unlike float64, sums and differences of Mana are exact,
+// so accounting over many bets and transactions does not accumulate rounding error. Use + and - directly, and Mul or
+// Div for scaling.
+//
+// Mana marshals to and from a JSON number, decoding the decimal text exactly, so it can be used in place of float64
+// fields in caller-defined types. The monetary fields of bets, transactions and users are available as Mana through
+// AmountMana, FeesMana, BalanceMana and CashBalanceMana, which convert the float64 fields on demand.
+type Mana int64
+
+// ManaFromFloat converts a float64 amount of mana to Mana, rounding to the nearest millionth. The conversion is exact
+// for amounts decoded from decimal text with up to six decimal places and below about 9 billion, as the nearest float64
+// is always closer to that text than half a millionth.
+func ManaFromFloat(amount float64) Mana {
+	return Mana(math.Round(amount * manaScale))
+}
+
+// Float64 converts the amount to a float64.
+func (m Mana) Float64() float64 {
+	return float64(m) / manaScale
+}
+
+// Mul multiplies the amount by a factor, rounding to the nearest millionth.
+func (m Mana) Mul(factor float64) Mana {
+	return Mana(math.Round(float64(m) * factor))
+}
+
+// Div divides the amount into n equal parts, rounding towards zero. The remainder is returned separately,
+// so that no mana is lost when splitting an amount.
+func (m Mana) Div(n int64) (Mana, Mana) {
+	return m / Mana(n), m % Mana(n)
+}
+
+// String formats the amount the way the site displays it, with two decimal places (see FormatMana).
+func (m Mana) String() string {
+	return FormatMana(m.Float64(), 2)
+}
+
+// MarshalJSON encodes the amount as a JSON number with up to six decimal places.
+func (m Mana) MarshalJSON() ([]byte, error) {
+	return []byte(new(big.Rat).SetFrac64(int64(m), manaScale).FloatString(6)), nil
+}
+
+// UnmarshalJSON decodes a JSON number into the amount exactly, rounding to the nearest millionth. A JSON null leaves
+// the amount unchanged.
+func (m *Mana) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+
+	var number json.Number
+	if err := json.Unmarshal(data, &number); err != nil {
+		return err
+	}
+
+	r, ok := new(big.Rat).SetString(number.String())
+	if !ok {
+		return fmt.Errorf("Mana: invalid number %q", number)
+	}
+
+	r.Mul(r, big.NewRat(manaScale, 1))
+
+	// Round half away from zero.
+	q, rem := new(big.Int).QuoRem(r.Num(), r.Denom(), new(big.Int))
+	if new(big.Int).Mul(new(big.Int).Abs(rem), big.NewInt(2)).Cmp(r.Denom()) >= 0 {
+		q.Add(q, big.NewInt(int64(r.Sign())))
+	}
+
+	if !q.IsInt64() {
+		return fmt.Errorf("Mana: %q is out of range", number)
+	}
+
+	*m = Mana(q.Int64())
+	return nil
+}
+
+// AmountMana returns the amount of the bet as fixed-point Mana (see ManaFromFloat).
+func (b *Bet) AmountMana() Mana {
+	return ManaFromFloat(b.Amount)
+}
+
+// FeesMana returns the total fees of the bet as fixed-point Mana, converting each fee separately so that their sum is exact.
+func (b *Bet) FeesMana() Mana {
+	return ManaFromFloat(b.Fees.CreatorFee) + ManaFromFloat(b.Fees.PlatformFee) + ManaFromFloat(b.Fees.LiquidityFee)
+}
+
+// AmountMana returns the amount of the transaction as fixed-point Mana (see ManaFromFloat).
+func (t *Txn) AmountMana() Mana {
+	return ManaFromFloat(t.Amount)
+}
+
+// BalanceMana returns the balance of the user as fixed-point Mana (see ManaFromFloat).
+func (u *User) BalanceMana() Mana {
+	return ManaFromFloat(u.Balance)
+}
@@ -0,0 +1,86 @@
+package manifold
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestManaUnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		json    string
+		initial Mana
+		want    Mana
+		wantErr bool
+	}{
+		{name: "integer", json: `12`, want: 12_000_000},
+		{name: "decimal", json: `0.1`, want: 100_000},
+		{name: "rounds half away from zero", json: `0.0000005`, want: 1},
+		{name: "rounds negative half away from zero", json: `-0.0000005`, want: -1},
+		{name: "rounds down", json: `1.0000004`, want: 1_000_000},
+		{name: "exponent", json: `1.5e2`, want: 150_000_000},
+		{name: "beyond float64 precision", json: `12345678901.1234565`, want: 12_345_678_901_123_457},
+		{name: "null leaves the value unchanged", json: `null`, initial: 42, want: 42},
+		{name: "quoted number", json: `"1"`, want: 1_000_000},
+		{name: "not a number", json: `"abc"`, wantErr: true},
+		{name: "out of range", json: `1e20`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.initial
+			err := json.Unmarshal([]byte(tt.json), &got)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Unmarshal() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && got != tt.want {
+				t.Errorf("Unmarshal() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestManaMarshalJSON(t *testing.T) {
+	data, err := json.Marshal(Mana(-1_500_001))
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	if string(data) != "-1.500001" {
+		t.Errorf("Marshal() = %s, want -1.500001", data)
+	}
+}
+
+func TestManaAccessors(t *testing.T) {
+	var bet Bet
+	if err := json.Unmarshal([]byte(`{"id":"b","amount":1234567.123457,"fees":{"creatorFee":0.1,"platformFee":0.2,"liquidityFee":0}}`), &bet); err != nil {
+		t.Fatalf("Unmarshal(Bet) error = %v", err)
+	}
+	if got := bet.AmountMana(); got != 1_234_567_123_457 {
+		t.Errorf("AmountMana() = %d, want 1234567123457", got)
+	}
+	if got := bet.FeesMana(); got != 300_000 {
+		t.Errorf("FeesMana() = %d, want 300000", got)
+	}
+
+	// The accessors follow changes to the float64 fields.
+	bet.Amount = 2.5
+	if got := bet.AmountMana(); got != 2_500_000 {
+		t.Errorf("AmountMana() after change = %d, want 2500000", got)
+	}
+
+	var txn Txn
+	if err := json.Unmarshal([]byte(`{"id":"t","amount":0.30000000000000004}`), &txn); err != nil {
+		t.Fatalf("Unmarshal(Txn) error = %v", err)
+	}
+	if got := txn.AmountMana(); got != 300_000 {
+		t.Errorf("Txn.AmountMana() = %d, want 300000", got)
+	}
+
+	var user User
+	if err := json.Unmarshal([]byte(`{"id":"u","balance":1000.000001,"cashBalance":2.5}`), &user); err != nil {
+		t.Fatalf("Unmarshal(User) error = %v", err)
+	}
+	if user.BalanceMana() != 1_000_000_001 || user.CashBalanceMana() != 2_500_000 {
+		t.Errorf("BalanceMana() = %d, CashBalanceMana() = %d", user.BalanceMana(), user.CashBalanceMana())
+	}
+}
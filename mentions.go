@@ -0,0 +1,246 @@
+package manifold
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// Mention is a reference to a user in TipTap content.
+type Mention struct {
+	UserID   string // ID of the user, or empty if the mention was plain text and has not been resolved
+	Username string // Username of the user
+}
+
+// MarketLink is a reference to a market in TipTap content.
+type MarketLink struct {
+	ContractID string // ID of the market, if the reference was a market mention node
+	Username   string // Username of the market's creator, if the reference was a link
+	Slug       string // Slug of the market
+	URL        string // URL of the link, if the reference was a link
+}
+
+// References are the users and markets referenced in TipTap content, each listed once in order of appearance.
+type References struct {
+	Mentions []Mention
+	Markets  []MarketLink
+}
+
+// tiptapNode is a node of a TipTap document.
+type tiptapNode struct {
+	Type    string         `json:"type"`
+	Text    string         `json:"text"`
+	Attrs   map[string]any `json:"attrs"`
+	Marks   []tiptapNode   `json:"marks"`
+	Content []tiptapNode   `json:"content"`
+}
+
+var (
+	plainMentionPattern = regexp.MustCompile(`(?:^|[^\w@])@(\w+)`)
+	marketPathPattern   = regexp.MustCompile(`^/([^/]+)/([^/?#]+)$`)
+)
+
+// ExtractReferences extracts the user mentions and market links from TipTap content, such as Comment.Content or
+// FullMarket.Description. Mention nodes carry the user's ID, while @username in plain text only carries the username
+// and can be resolved with a MentionResolver.
+//
+// Parameters:
+//   - content: The TipTap JSON document. Required.
+//
+// Returns:
+//   - *References: A pointer to the references found.
+//   - error: An error object if the content is not valid TipTap JSON.
+func ExtractReferences(content json.RawMessage) (*References, error) {
+	var root tiptapNode
+	if err := json.Unmarshal(content, &root); err != nil {
+		return nil, fmt.Errorf("ExtractReferences: %w: %w", ErrorFailedToParseResponse, err)
+	}
+
+	refs := new(References)
+	users := make(map[string]bool)
+	markets := make(map[string]bool)
+
+	addMention := func(m Mention) {
+		key := strings.ToLower(m.Username)
+		if key == "" {
+			key = m.UserID
+		}
+		if !users[key] {
+			users[key] = true
+			refs.Mentions = append(refs.Mentions, m)
+		}
+	}
+	addMarket := func(m MarketLink) {
+		key := m.ContractID
+		if key == "" {
+			key = m.Username + "/" + m.Slug
+		}
+		if !markets[key] {
+			markets[key] = true
+			refs.Markets = append(refs.Markets, m)
+		}
+	}
+	attr := func(n tiptapNode, key string) string {
+		value, _ := n.Attrs[key].(string)
+		return value
+	}
+
+	var walk func(n tiptapNode)
+	walk = func(n tiptapNode) {
+		switch n.Type {
+		case "mention":
+			addMention(Mention{UserID: attr(n, "id"), Username: attr(n, "label")})
+		case "contract-mention":
+			addMarket(MarketLink{ContractID: attr(n, "id"), Slug: attr(n, "label")})
+		case "text":
+			for _, match := range plainMentionPattern.FindAllStringSubmatch(n.Text, -1) {
+				addMention(Mention{Username: match[1]})
+			}
+			for _, mark := range n.Marks {
+				if mark.Type == "link" {
+					if link, ok := parseMarketLink(attr(mark, "href")); ok {
+						addMarket(link)
+					}
+				}
+			}
+		}
+
+		for _, child := range n.Content {
+			walk(child)
+		}
+	}
+	walk(root)
+
+	return refs, nil
+}
+
+// PlainText extracts the text of TipTap content, with block nodes separated by newlines.
+//
+// Parameters:
+//   - content: The TipTap JSON document. Required.
+//
+// Returns:
+//   - string: The plain text of the document.
+//   - error: An error object if the content is not valid TipTap JSON.
+func PlainText(content json.RawMessage) (string, error) {
+	var root tiptapNode
+	if err := json.Unmarshal(content, &root); err != nil {
+		return "", fmt.Errorf("PlainText: %w: %w", ErrorFailedToParseResponse, err)
+	}
+
+	var b strings.Builder
+	var walk func(n tiptapNode)
+	walk = func(n tiptapNode) {
+		switch n.Type {
+		case "text":
+			b.WriteString(n.Text)
+		case "mention":
+			label, _ := n.Attrs["label"].(string)
+			b.WriteString("@" + label)
+		case "hardBreak":
+			b.WriteString("\n")
+		}
+
+		for _, child := range n.Content {
+			walk(child)
+		}
+
+		if n.Type == "paragraph" || n.Type == "heading" || n.Type == "listItem" {
+			b.WriteString("\n")
+		}
+	}
+	walk(root)
+
+	return strings.TrimSpace(b.String()), nil
+}
+
+// parseMarketLink parses a link to a market on manifold.markets or dev.manifold.markets.
+func parseMarketLink(href string) (MarketLink, bool) {
+	u, err := url.Parse(href)
+	if err != nil || (u.Host != "manifold.markets" && u.Host != "www.manifold.markets" && u.Host != "dev.manifold.markets") {
+		return MarketLink{}, false
+	}
+
+	match := marketPathPattern.FindStringSubmatch(u.Path)
+	if match == nil {
+		return MarketLink{}, false
+	}
+
+	return MarketLink{Username: match[1], Slug: match[2], URL: href}, true
+}
+
+// MentionResolver resolves the usernames of plain text mentions to user IDs, caching lookups.
+type MentionResolver struct {
+	client *Client
+
+	mu    sync.Mutex
+	cache map[string]*DisplayUser
+}
+
+// NewMentionResolver creates a new mention resolver with an empty cache.
+//
+// Parameters:
+//   - client: The client used to look up users. Required.
+//
+// Returns:
+//   - *MentionResolver: A pointer to the newly created resolver.
+func NewMentionResolver(client *Client) *MentionResolver {
+	return &MentionResolver{
+		client: client,
+		cache:  make(map[string]*DisplayUser),
+	}
+}
+
+// Resolve fills in the user IDs of mentions that only have a username. Usernames that do not belong to a user,
+// such as email addresses or other text starting with @, are removed.
+//
+// Parameters:
+//   - mentions: The mentions to resolve. Required.
+//
+// Returns:
+//   - []Mention: The resolved mentions, in the same order.
+//   - error: An error object if a lookup request fails.
+func (r *MentionResolver) Resolve(mentions []Mention) ([]Mention, error) {
+	resolved := make([]Mention, 0, len(mentions))
+
+	for _, mention := range mentions {
+		if mention.UserID != "" {
+			resolved = append(resolved, mention)
+			continue
+		}
+
+		key := strings.ToLower(mention.Username)
+		r.mu.Lock()
+		user, ok := r.cache[key]
+		r.mu.Unlock()
+
+		if !ok {
+			var err error
+			user, err = r.client.User.UserLite(mention.Username)
+
+			// Unknown usernames come back as a 404.
+			var apiErr *APIError
+			if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+				user, err = nil, nil
+			}
+			if err != nil {
+				return nil, fmt.Errorf("MentionResolver: Resolve: %w", err)
+			}
+
+			r.mu.Lock()
+			r.cache[key] = user
+			r.mu.Unlock()
+		}
+
+		if user != nil {
+			resolved = append(resolved, Mention{UserID: user.ID, Username: user.Username})
+		}
+	}
+
+	return resolved, nil
+}
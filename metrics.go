@@ -0,0 +1,81 @@
+package manifold
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+// RequestObservation describes a single request attempt, for recording in a metrics system.
+type RequestObservation struct {
+	Method      string        // HTTP method of the request
+	Endpoint    string        // Endpoint the request was sent to
+	Route       string        // Endpoint with IDs, usernames and slugs replaced by {id}, for use as a low-cardinality label
+	Status      int           // HTTP status of the response, or 0 if none was received
+	Latency     time.Duration // Time taken by the request
+	Attempt     int           // Retry attempt, starting at 0
+	Err         error         // Error returned for the attempt, if any
+	RateLimited bool          // Whether the request was rejected for rate limiting
+}
+
+// Metrics records observations of the client's requests, e.g. as Prometheus counters and histograms of requests
+// by route, latency, errors and rate limit hits. The library has no metrics dependency; an adapter to the metrics
+// system in use implements this interface.
+type Metrics interface {
+	ObserveRequest(observation RequestObservation)
+}
+
+// MetricsFunc adapts a function to the Metrics interface.
+type MetricsFunc func(observation RequestObservation)
+
+// ObserveRequest calls f.
+func (f MetricsFunc) ObserveRequest(observation RequestObservation) {
+	f(observation)
+}
+
+// WithMetrics makes the client report every request attempt to a metrics system.
+//
+// Parameters:
+//   - metrics: The metrics system to report to. Required.
+//
+// Returns:
+//   - Option: An option to pass to NewClient.
+func WithMetrics(metrics Metrics) Option {
+	return func(c *Client) {
+		c.metrics = metrics
+	}
+}
+
+// observeRequest reports a request attempt to the metrics system, if one is set.
+func (c *Client) observeRequest(method string, endpoint string, status int, latency time.Duration, attempt int, err error) {
+	if c.metrics == nil {
+		return
+	}
+
+	c.metrics.ObserveRequest(RequestObservation{
+		Method:      method,
+		Endpoint:    endpoint,
+		Route:       route(endpoint),
+		Status:      status,
+		Latency:     latency,
+		Attempt:     attempt,
+		Err:         err,
+		RateLimited: errors.Is(err, ErrorRateLimited),
+	})
+}
+
+// routeParents are the path segments followed by an ID, username or slug in API endpoints.
+var routeParents = map[string]bool{"market": true, "user": true, "by-id": true, "slug": true, "group": true, "cancel": true}
+
+// route replaces the IDs, usernames and slugs in an endpoint with {id}, e.g. /market/abc/positions becomes
+// /market/{id}/positions.
+func route(endpoint string) string {
+	segments := strings.Split(endpoint, "/")
+	for i := 1; i < len(segments); i++ {
+		if routeParents[segments[i-1]] && segments[i] != "by-id" && segments[i] != "" {
+			segments[i] = "{id}"
+		}
+	}
+
+	return strings.Join(segments, "/")
+}
@@ -0,0 +1,94 @@
+package manifold
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// Microstructure summarizes how a market trades, to help decide whether it is worth market-making.
+type Microstructure struct {
+	Trades          int     // Number of trades counted
+	MeanTradeSize   float64 // Mean absolute amount per trade
+	MedianTradeSize float64 // Median absolute amount per trade
+	P90TradeSize    float64 // 90th percentile of absolute amount per trade
+	LimitShare      float64 // Fraction of trades that were limit orders
+	UpVolume        float64 // Volume pushing the probability up: YES buys and NO sales
+	DownVolume      float64 // Volume pushing the probability down: NO buys and YES sales
+	Imbalance       float64 // (UpVolume - DownVolume) / (UpVolume + DownVolume), between -1 and 1
+}
+
+// MicrostructureStats computes trade size distribution, limit order share, and buy/sell imbalance from a market's bet history.
+// Redemptions and limit orders that have not been filled at all are ignored.
+//
+// Parameters:
+//   - bets: The bet history of the market. Required.
+//
+// Returns:
+//   - *Microstructure: A pointer to the statistics.
+//   - error: An error object if there are no trades to compute statistics from.
+func MicrostructureStats(bets []Bet) (*Microstructure, error) {
+	var (
+		stats  Microstructure
+		sizes  []float64
+		limits int
+	)
+
+	for _, bet := range bets {
+		if bet.IsRedemption || bet.Amount == 0 || (bet.Outcome != "YES" && bet.Outcome != "NO") {
+			continue
+		}
+
+		size := math.Abs(bet.Amount)
+		sizes = append(sizes, size)
+
+		if bet.LimitProps != nil {
+			limits++
+		}
+
+		// A sale of one outcome pushes the probability the same way as a purchase of the other.
+		up := bet.Outcome == "YES"
+		if bet.Amount < 0 {
+			up = !up
+		}
+
+		if up {
+			stats.UpVolume += size
+		} else {
+			stats.DownVolume += size
+		}
+	}
+
+	if len(sizes) == 0 {
+		return nil, fmt.Errorf("MicrostructureStats: no trades")
+	}
+
+	sort.Float64s(sizes)
+
+	var total float64
+	for _, size := range sizes {
+		total += size
+	}
+
+	stats.Trades = len(sizes)
+	stats.MeanTradeSize = total / float64(len(sizes))
+	stats.MedianTradeSize = quantile(sizes, 0.5)
+	stats.P90TradeSize = quantile(sizes, 0.9)
+	stats.LimitShare = float64(limits) / float64(len(sizes))
+	stats.Imbalance = (stats.UpVolume - stats.DownVolume) / (stats.UpVolume + stats.DownVolume)
+
+	return &stats, nil
+}
+
+// quantile returns the q-th quantile of sorted values, interpolating linearly between neighbours.
+func quantile(sorted []float64, q float64) float64 {
+	if len(sorted) == 1 {
+		return sorted[0]
+	}
+
+	pos := q * float64(len(sorted)-1)
+	lower := int(math.Floor(pos))
+	upper := int(math.Ceil(pos))
+
+	return sorted[lower] + (sorted[upper]-sorted[lower])*(pos-float64(lower))
+}
@@ -0,0 +1,247 @@
+package manifold
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// OCOOrder is a client-managed pair of limit orders where a fill on either order cancels the other.
+// Fills are detected by polling, so a small amount of both orders may fill before the other is cancelled.
+type OCOOrder struct {
+	client     *Client
+	userID     string
+	contractID string
+
+	mu     sync.Mutex
+	orders [2]*Bet
+	filled int
+	done   bool
+}
+
+// OCOLeg describes one of the two limit orders of an OCO pair.
+type OCOLeg struct {
+	Outcome   string     // Outcome to buy ("YES" or "NO")
+	Amount    float64    // Amount of the order
+	LimitProb float64    // Probability threshold for the order
+	ExpiresAt *time.Time // Expiration time for the order (optional)
+}
+
+// PlaceOCO places both legs of an OCO pair on a market. If the second leg cannot be placed, the first leg is cancelled.
+//
+// Parameters:
+//   - client: The client used to place, poll, and cancel the orders. Required.
+//   - contractID: The ID of the market to trade in. Required.
+//   - first: The first limit order of the pair. Required.
+//   - second: The second limit order of the pair. Required.
+//
+// Returns:
+//   - *OCOOrder: A pointer to the placed OCO pair.
+//   - error: An error object if either order cannot be placed.
+func PlaceOCO(client *Client, contractID string, first OCOLeg, second OCOLeg) (*OCOOrder, error) {
+	a, err := client.Bet.Create(first.Amount, contractID, &first.Outcome, &first.LimitProb, first.ExpiresAt, nil)
+	if err != nil {
+		return nil, fmt.Errorf("OCO: PlaceOCO(first): %w", err)
+	}
+
+	b, err := client.Bet.Create(second.Amount, contractID, &second.Outcome, &second.LimitProb, second.ExpiresAt, nil)
+	if err != nil {
+		if cancelErr := client.Bet.Cancel(a.ID); cancelErr != nil {
+			return nil, fmt.Errorf("OCO: PlaceOCO(second): %w (first order %s left open: %w)", err, a.ID, cancelErr)
+		}
+
+		return nil, fmt.Errorf("OCO: PlaceOCO(second): %w", err)
+	}
+
+	o := &OCOOrder{client: client, userID: a.UserID, contractID: contractID, orders: [2]*Bet{a, b}, filled: -1}
+	o.settle()
+
+	if err := o.cancelOther(); err != nil {
+		return o, fmt.Errorf("OCO: PlaceOCO: %w", err)
+	}
+
+	return o, nil
+}
+
+// ResumeOCO resumes tracking an OCO pair placed earlier, for example after a restart, from the IDs of its orders.
+// Callers that need crash safety should persist IDs() after PlaceOCO and call ResumeOCO on startup.
+//
+// Parameters:
+//   - client: The client used to poll and cancel the orders. Required.
+//   - userID: The ID of the user who placed the orders. Required.
+//   - contractID: The ID of the market the orders were placed in. Required.
+//   - ids: The IDs of the two orders. Required.
+//
+// Returns:
+//   - *OCOOrder: A pointer to the resumed OCO pair, with its state refreshed from the API.
+//   - error: An error object if a request fails or if either order cannot be found.
+func ResumeOCO(client *Client, userID string, contractID string, ids [2]string) (*OCOOrder, error) {
+	o := &OCOOrder{client: client, userID: userID, contractID: contractID, filled: -1}
+
+	for i, id := range ids {
+		bet, err := client.Bet.findBet(userID, contractID, id, 0)
+		if err != nil {
+			return nil, fmt.Errorf("OCO: ResumeOCO: %w", err)
+		}
+
+		if bet == nil {
+			return nil, fmt.Errorf("OCO: ResumeOCO: order %s not found", id)
+		}
+
+		o.orders[i] = bet
+	}
+
+	if err := o.Check(); err != nil {
+		return o, fmt.Errorf("OCO: ResumeOCO: %w", err)
+	}
+
+	return o, nil
+}
+
+// IDs returns the IDs of the two orders of the pair.
+func (o *OCOOrder) IDs() [2]string {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	return [2]string{o.orders[0].ID, o.orders[1].ID}
+}
+
+// Filled returns the order that was filled first, or nil if neither has been filled yet.
+func (o *OCOOrder) Filled() *Bet {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if o.filled < 0 {
+		return nil
+	}
+
+	return o.orders[o.filled]
+}
+
+// Done reports whether the pair has been resolved, either because one order was filled or both were cancelled.
+func (o *OCOOrder) Done() bool {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	return o.done
+}
+
+// Check refreshes both orders and cancels the other order once either has been filled.
+//
+// Returns:
+//   - error: An error object if a request fails or if the response cannot be parsed.
+func (o *OCOOrder) Check() error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if o.done {
+		return nil
+	}
+
+	for i, order := range o.orders {
+		bet, err := o.client.Bet.findBet(o.userID, o.contractID, order.ID, order.CreatedTime)
+		if err != nil {
+			return fmt.Errorf("OCO: Check: %w", err)
+		}
+
+		if bet != nil {
+			o.orders[i] = bet
+		}
+	}
+
+	o.settle()
+
+	if err := o.cancelOther(); err != nil {
+		return fmt.Errorf("OCO: Check: %w", err)
+	}
+
+	return nil
+}
+
+// Cancel cancels both orders. Both cancellations are attempted even if the first fails.
+//
+// Returns:
+//   - error: An error object joining the errors of the requests that failed.
+func (o *OCOOrder) Cancel() error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	o.done = true
+	var errs []error
+	for _, order := range o.orders {
+		if order.LimitProps != nil && (order.LimitProps.IsCancelled || order.LimitProps.IsFilled) {
+			continue
+		}
+
+		if err := o.client.Bet.Cancel(order.ID); err != nil {
+			errs = append(errs, fmt.Errorf("OCO: Cancel: %w", err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Run calls Check at the given interval until the pair is done or stop is closed. Errors from Check are passed to onError.
+//
+// Parameters:
+//   - interval: The time between checks. Required.
+//   - stop: A channel that stops the checks when closed. Both orders are left in place. Required.
+//   - onError: Called with every error returned by Check. Optional.
+func (o *OCOOrder) Run(interval time.Duration, stop <-chan struct{}, onError func(error)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for !o.Done() {
+		if err := o.Check(); err != nil && onError != nil {
+			onError(err)
+		}
+
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// settle records which order has been filled, if any. Must be called with the mutex held.
+func (o *OCOOrder) settle() {
+	if o.filled >= 0 {
+		return
+	}
+
+	cancelled := 0
+	for i, order := range o.orders {
+		if order.LimitProps == nil || len(order.LimitProps.Fills) > 0 || order.LimitProps.IsFilled {
+			o.filled = i
+			return
+		}
+
+		if order.LimitProps.IsCancelled {
+			cancelled++
+		}
+	}
+
+	if cancelled == len(o.orders) {
+		o.done = true
+	}
+}
+
+// cancelOther cancels the order that was not filled, once one has been. Must be called with the mutex held.
+func (o *OCOOrder) cancelOther() error {
+	if o.filled < 0 || o.done {
+		return nil
+	}
+
+	other := o.orders[1-o.filled]
+	if other.LimitProps != nil && !other.LimitProps.IsCancelled && !other.LimitProps.IsFilled {
+		if err := o.client.Bet.Cancel(other.ID); err != nil {
+			return err
+		}
+	}
+
+	o.done = true
+
+	return nil
+}
@@ -0,0 +1,39 @@
+package manifold
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestOCOOrderCancel(t *testing.T) {
+	var cancelled []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := strings.TrimPrefix(r.URL.Path, "/bet/cancel/")
+		cancelled = append(cancelled, id)
+		if id == "a" {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"message":"cannot cancel"}`))
+			return
+		}
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("key")
+	client.BaseURL = server.URL
+
+	o := &OCOOrder{client: client, contractID: "m", orders: [2]*Bet{{ID: "a"}, {ID: "b"}}, filled: -1}
+	err := o.Cancel()
+
+	if err == nil || !strings.Contains(err.Error(), "cannot cancel") {
+		t.Errorf("Cancel() error = %v, want the first cancellation's error", err)
+	}
+	if strings.Join(cancelled, ",") != "a,b" {
+		t.Errorf("cancelled %v, want both orders", cancelled)
+	}
+	if !o.Done() {
+		t.Error("Done() = false, want true")
+	}
+}
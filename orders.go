@@ -0,0 +1,405 @@
+package manifold
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+)
+
+// OrderState is the state of an order managed by an OrderManager.
+type OrderState string
+
+const (
+	OrderPending         OrderState = "PENDING"          // Submitted, with no response from the API yet
+	OrderAcked           OrderState = "ACKED"            // Accepted by the API and resting, with no fills
+	OrderPartiallyFilled OrderState = "PARTIALLY_FILLED" // Resting with some fills
+	OrderFilled          OrderState = "FILLED"           // Fully filled
+	OrderCancelled       OrderState = "CANCELLED"        // Cancelled or expired, possibly with some fills
+	OrderUnknown         OrderState = "UNKNOWN"          // Submission failed without telling whether the order was placed
+)
+
+// Terminal reports whether the state is final.
+func (s OrderState) Terminal() bool {
+	return s == OrderFilled || s == OrderCancelled
+}
+
+// orderUnknownGrace is how long an order in OrderUnknown is searched for before it is considered never placed.
+const orderUnknownGrace = time.Minute
+
+// ManagedOrder is a limit order tracked by an OrderManager.
+type ManagedOrder struct {
+	Key             string     `json:"key"`                 // Local key of the order, stable across its lifetime
+	BetID           string     `json:"betId,omitempty"`     // ID of the bet, once known
+	ContractID      string     `json:"contractId"`          // ID of the market
+	Outcome         string     `json:"outcome"`             // Outcome of the order ("YES" or "NO")
+	Amount          float64    `json:"amount"`              // Amount of the order
+	LimitProb       float64    `json:"limitProb"`           // Limit probability of the order
+	ExpiresAt       *time.Time `json:"expiresAt,omitempty"` // Expiration time of the order (optional)
+	State           OrderState `json:"state"`               // Current state of the order
+	Filled          float64    `json:"filled"`              // Amount filled so far
+	CancelRequested bool       `json:"cancelRequested"`     // Whether the order has been asked to be cancelled
+	SubmittedTime   time.Time  `json:"submittedTime"`       // Time the order was submitted
+	UpdatedTime     time.Time  `json:"updatedTime"`         // Time the order last changed state
+}
+
+// OrderTransition reports a change in the state of a managed order.
+type OrderTransition struct {
+	Order ManagedOrder // The order after the change
+	From  OrderState   // Previous state
+	To    OrderState   // New state
+}
+
+// OrderManager tracks limit orders through their lifecycle and periodically reconciles its local state against
+// the API, resolving discrepancies such as a cancelled order that is still open or an order whose submission
+// failed but which was placed anyway.
+type OrderManager struct {
+	client       *Client
+	userID       string
+	onTransition func(OrderTransition)
+
+	mu     sync.Mutex
+	orders map[string]*ManagedOrder
+	next   int
+}
+
+// NewOrderManager creates a new order manager.
+//
+// Parameters:
+//   - client: The client used to place, cancel and poll orders. Required.
+//   - userID: The ID of the user placing the orders. Required.
+//   - onTransition: Called for every change in the state of an order. Optional.
+//
+// Returns:
+//   - *OrderManager: A pointer to the newly created order manager, with no orders.
+func NewOrderManager(client *Client, userID string, onTransition func(OrderTransition)) *OrderManager {
+	return &OrderManager{
+		client:       client,
+		userID:       userID,
+		onTransition: onTransition,
+		orders:       make(map[string]*ManagedOrder),
+	}
+}
+
+// Orders returns a copy of every managed order, ordered by submission time.
+//
+// Returns:
+//   - []ManagedOrder: The managed orders.
+func (m *OrderManager) Orders() []ManagedOrder {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	orders := make([]ManagedOrder, 0, len(m.orders))
+	for _, order := range m.orders {
+		orders = append(orders, *order)
+	}
+
+	sort.Slice(orders, func(i, j int) bool {
+		return orders[i].SubmittedTime.Before(orders[j].SubmittedTime)
+	})
+
+	return orders
+}
+
+// Order returns a copy of a managed order.
+//
+// Parameters:
+//   - key: The local key of the order. Required.
+//
+// Returns:
+//   - ManagedOrder: The order.
+//   - bool: Whether the order is managed.
+func (m *OrderManager) Order(key string) (ManagedOrder, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	order, ok := m.orders[key]
+	if !ok {
+		return ManagedOrder{}, false
+	}
+
+	return *order, true
+}
+
+// Place submits a new limit order and tracks it. If the submission fails in a way that leaves it unclear whether
+// the order was placed, the order is kept in OrderUnknown and resolved by the next reconciliations.
+//
+// Parameters:
+//   - contractID: The ID of the market. Required.
+//   - outcome: The outcome to buy ("YES" or "NO"). Required.
+//   - amount: The amount of the order. Required.
+//   - limitProb: The limit probability of the order. Must be between 0 and 1. Required.
+//   - expiresAt: Expiration time of the order. Optional.
+//
+// Returns:
+//   - ManagedOrder: The order after submission.
+//   - error: An error object if the submission fails.
+func (m *OrderManager) Place(contractID string, outcome string, amount float64, limitProb float64, expiresAt *time.Time) (ManagedOrder, error) {
+	m.mu.Lock()
+	m.next++
+	now := time.Now()
+	order := &ManagedOrder{
+		Key:           fmt.Sprintf("order-%d-%d", now.UnixMilli(), m.next),
+		ContractID:    contractID,
+		Outcome:       outcome,
+		Amount:        amount,
+		LimitProb:     limitProb,
+		ExpiresAt:     expiresAt,
+		State:         OrderPending,
+		SubmittedTime: now,
+		UpdatedTime:   now,
+	}
+	m.orders[order.Key] = order
+	m.mu.Unlock()
+
+	bet, err := m.client.Bet.Create(amount, contractID, &outcome, &limitProb, expiresAt, nil)
+	if err != nil {
+		// Failures after the request was sent may still have placed the order, unless the API rejected it outright.
+		// Anything else failed validation.
+		var apiErr *APIError
+		rejected := errors.Is(err, ErrorRateLimited) || (errors.As(err, &apiErr) && apiErr.StatusCode < http.StatusInternalServerError)
+		if !rejected && (errors.Is(err, ErrorPOSTFailed) || errors.Is(err, ErrorFailedToParseResponse)) {
+			m.transition(order.Key, func(o *ManagedOrder) { o.State = OrderUnknown })
+		} else {
+			m.mu.Lock()
+			delete(m.orders, order.Key)
+			m.mu.Unlock()
+		}
+
+		return m.snapshot(order.Key), fmt.Errorf("OrderManager: Place: %w", err)
+	}
+
+	m.transition(order.Key, func(o *ManagedOrder) { o.apply(bet, time.Now()) })
+
+	return m.snapshot(order.Key), nil
+}
+
+// Cancel requests the cancellation of a managed order. The order is only marked cancelled once reconciliation
+// confirms it, and the cancellation is retried by reconciliation if the order is still open. Orders that have not
+// been acknowledged yet are cancelled by reconciliation once their bet is known.
+//
+// Parameters:
+//   - key: The local key of the order. Required.
+//
+// Returns:
+//   - error: An error object if the order is not managed or the request fails.
+func (m *OrderManager) Cancel(key string) error {
+	m.mu.Lock()
+	order, ok := m.orders[key]
+	if !ok {
+		m.mu.Unlock()
+		return fmt.Errorf("OrderManager: Cancel: unknown order %s", key)
+	}
+
+	if order.State.Terminal() {
+		m.mu.Unlock()
+		return nil
+	}
+
+	order.CancelRequested = true
+	betID := order.BetID
+	m.mu.Unlock()
+
+	if betID == "" {
+		return nil
+	}
+
+	if err := m.client.Bet.Cancel(betID); err != nil {
+		return fmt.Errorf("OrderManager: Cancel: %w", err)
+	}
+
+	return nil
+}
+
+// Reconcile compares the state of every open managed order against the API and resolves discrepancies:
+// fills and cancellations are picked up, orders in OrderUnknown are found or given up on, and orders asked to be
+// cancelled that are still open are cancelled again.
+//
+// Returns:
+//   - error: An error object if a request fails. Remaining orders are still reconciled.
+func (m *OrderManager) Reconcile() error {
+	limit, kinds := 1000, "open-limit"
+	open, err := m.client.Bet.Bets(&m.userID, nil, nil, nil, &limit, nil, nil, nil, nil, &kinds, nil)
+	if err != nil {
+		return fmt.Errorf("OrderManager: Reconcile: %w", err)
+	}
+
+	openByID := make(map[string]*Bet, len(open))
+	for i := range open {
+		openByID[open[i].ID] = &open[i]
+	}
+
+	var firstErr error
+	fail := func(err error) {
+		if firstErr == nil {
+			firstErr = fmt.Errorf("OrderManager: Reconcile: %w", err)
+		}
+	}
+
+	for _, order := range m.Orders() {
+		if order.State.Terminal() {
+			continue
+		}
+
+		if order.BetID == "" {
+			if order.State != OrderUnknown {
+				continue
+			}
+
+			bet, err := m.findUnknown(order)
+			if err != nil {
+				fail(err)
+				continue
+			}
+
+			if bet == nil {
+				if time.Since(order.SubmittedTime) > orderUnknownGrace {
+					m.transition(order.Key, func(o *ManagedOrder) { o.State = OrderCancelled })
+				}
+				continue
+			}
+
+			order.BetID = bet.ID
+			if _, ok := openByID[bet.ID]; !ok {
+				openByID[bet.ID] = bet
+			}
+		}
+
+		bet, ok := openByID[order.BetID]
+		if !ok {
+			// No longer open, so it was filled, cancelled or expired since the last reconciliation.
+			bet, err = m.client.Bet.findBet(m.userID, order.ContractID, order.BetID, order.SubmittedTime.Add(-orderUnknownGrace).UnixMilli())
+			if err != nil {
+				fail(err)
+				continue
+			}
+			if bet == nil {
+				continue
+			}
+		}
+
+		now := time.Now()
+		m.transition(order.Key, func(o *ManagedOrder) { o.apply(bet, now) })
+
+		if updated := m.snapshot(order.Key); updated.CancelRequested && !updated.State.Terminal() {
+			if err := m.client.Bet.Cancel(updated.BetID); err != nil {
+				fail(err)
+			}
+		}
+	}
+
+	return firstErr
+}
+
+// findUnknown searches for the bet of an order whose submission outcome is unknown, among the user's bets on the
+// market placed since shortly before it was submitted that are not already managed.
+func (m *OrderManager) findUnknown(order ManagedOrder) (*Bet, error) {
+	after := order.SubmittedTime.Add(-orderUnknownGrace)
+	bets, err := m.client.Bet.allBets(&m.userID, &order.ContractID, &after)
+	if err != nil {
+		return nil, err
+	}
+
+	m.mu.Lock()
+	managed := make(map[string]bool, len(m.orders))
+	for _, o := range m.orders {
+		if o.BetID != "" {
+			managed[o.BetID] = true
+		}
+	}
+	m.mu.Unlock()
+
+	for i := range bets {
+		bet := &bets[i]
+		if managed[bet.ID] || bet.LimitProps == nil || bet.Outcome != order.Outcome {
+			continue
+		}
+
+		if math.Abs(bet.LimitProps.LimitProb-order.LimitProb) < 1e-9 && math.Abs(bet.LimitProps.OrderAmount-order.Amount) < 1e-6 {
+			return bet, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// apply updates an order from the state of its bet as returned by the API.
+func (o *ManagedOrder) apply(bet *Bet, now time.Time) {
+	o.BetID = bet.ID
+
+	if bet.LimitProps == nil {
+		o.Filled = bet.Amount
+		o.State = OrderFilled
+		return
+	}
+
+	o.Filled = 0
+	for _, fill := range bet.LimitProps.Fills {
+		o.Filled += fill.Amount
+	}
+
+	switch {
+	case bet.LimitProps.IsFilled:
+		o.State = OrderFilled
+	case bet.LimitProps.IsCancelled || (bet.LimitProps.ExpiresAt != nil && *bet.LimitProps.ExpiresAt <= now.UnixMilli()):
+		o.State = OrderCancelled
+	case len(bet.LimitProps.Fills) > 0:
+		o.State = OrderPartiallyFilled
+	default:
+		o.State = OrderAcked
+	}
+}
+
+// transition applies a change to an order and reports it if its state changed.
+func (m *OrderManager) transition(key string, change func(o *ManagedOrder)) {
+	m.mu.Lock()
+	order, ok := m.orders[key]
+	if !ok {
+		m.mu.Unlock()
+		return
+	}
+
+	from := order.State
+	change(order)
+	if order.State != from {
+		order.UpdatedTime = time.Now()
+	}
+	updated := *order
+	m.mu.Unlock()
+
+	if updated.State != from && m.onTransition != nil {
+		m.onTransition(OrderTransition{Order: updated, From: from, To: updated.State})
+	}
+}
+
+// snapshot returns a copy of an order, or an empty order if it is no longer managed.
+func (m *OrderManager) snapshot(key string) ManagedOrder {
+	order, _ := m.Order(key)
+	return order
+}
+
+// Run calls Reconcile at the given interval until stop is closed. Errors from Reconcile are passed to onError.
+//
+// Parameters:
+//   - interval: The time between reconciliations. Required.
+//   - stop: A channel that stops the manager when closed. Required.
+//   - onError: Called with every error returned by Reconcile. Optional.
+func (m *OrderManager) Run(interval time.Duration, stop <-chan struct{}, onError func(error)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := m.Reconcile(); err != nil && onError != nil {
+			onError(err)
+		}
+
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,207 @@
+package manifold
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+// orderServer serves a user's bets and records cancelled bets, for testing an OrderManager.
+type orderServer struct {
+	*httptest.Server
+	bets      []Bet
+	cancelled []string
+}
+
+func newOrderServer(t *testing.T, bets []Bet) *orderServer {
+	s := &orderServer{bets: bets}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/bets":
+			query := r.URL.Query()
+			var bets []Bet
+			past := query.Get("before") == ""
+			limit, _ := strconv.Atoi(query.Get("limit"))
+			for _, bet := range s.bets {
+				if !past {
+					past = bet.ID == query.Get("before")
+					continue
+				}
+				if limit > 0 && len(bets) == limit {
+					break
+				}
+				resting := bet.LimitProps != nil && !bet.LimitProps.IsFilled && !bet.LimitProps.IsCancelled
+				if query.Get("kinds") == "open-limit" && !resting {
+					continue
+				}
+				if id := query.Get("contractId"); id != "" && id != bet.ContractID {
+					continue
+				}
+				bets = append(bets, bet)
+			}
+			json.NewEncoder(w).Encode(bets)
+		case strings.HasPrefix(r.URL.Path, "/bet/cancel/"):
+			id := strings.TrimPrefix(r.URL.Path, "/bet/cancel/")
+			s.cancelled = append(s.cancelled, id)
+			for i := range s.bets {
+				if s.bets[i].ID == id {
+					s.bets[i].LimitProps.IsCancelled = true
+				}
+			}
+			w.Write([]byte(`{}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(s.Close)
+
+	return s
+}
+
+func (s *orderServer) manager() *OrderManager {
+	client := NewClient("key")
+	client.BaseURL = s.URL
+
+	return NewOrderManager(client, "u1", nil)
+}
+
+func limitBet(id string, contractID string, amount float64, prob float64, fills ...float64) Bet {
+	bet := Bet{ID: id, UserID: "u1", ContractID: contractID, Outcome: "YES", LimitProps: &LimitProps{OrderAmount: amount, LimitProb: prob}}
+	for _, fill := range fills {
+		bet.LimitProps.Fills = append(bet.LimitProps.Fills, Fill{Amount: fill})
+	}
+
+	return bet
+}
+
+func TestOrderManagerReconcile(t *testing.T) {
+	filled := limitBet("filled", "m1", 10, 0.4, 10)
+	filled.LimitProps.IsFilled = true
+	server := newOrderServer(t, []Bet{
+		limitBet("acked", "m1", 10, 0.4),
+		limitBet("partial", "m1", 10, 0.4, 3),
+		filled,
+		limitBet("unknown", "m2", 20, 0.7),
+	})
+	manager := server.manager()
+
+	now := time.Now()
+	manager.orders = map[string]*ManagedOrder{
+		"a": {Key: "a", BetID: "acked", ContractID: "m1", Outcome: "YES", State: OrderAcked, SubmittedTime: now},
+		"p": {Key: "p", BetID: "partial", ContractID: "m1", Outcome: "YES", State: OrderAcked, SubmittedTime: now},
+		"f": {Key: "f", BetID: "filled", ContractID: "m1", Outcome: "YES", State: OrderAcked, SubmittedTime: now},
+		"u": {Key: "u", ContractID: "m2", Outcome: "YES", Amount: 20, LimitProb: 0.7, State: OrderUnknown, SubmittedTime: now},
+		"g": {Key: "g", ContractID: "m3", Outcome: "YES", Amount: 5, LimitProb: 0.5, State: OrderUnknown, SubmittedTime: now.Add(-time.Hour)},
+		"c": {Key: "c", BetID: "acked", ContractID: "m1", Outcome: "YES", State: OrderAcked, CancelRequested: true, SubmittedTime: now},
+	}
+
+	if err := manager.Reconcile(); err != nil {
+		t.Fatalf("Reconcile() error = %v", err)
+	}
+
+	want := map[string]OrderState{
+		"a": OrderAcked,
+		"p": OrderPartiallyFilled,
+		"f": OrderFilled,
+		"u": OrderAcked,
+		"g": OrderCancelled,
+	}
+	for key, state := range want {
+		if order, _ := manager.Order(key); order.State != state {
+			t.Errorf("order %s: State = %s, want %s", key, order.State, state)
+		}
+	}
+	if order, _ := manager.Order("u"); order.BetID != "unknown" {
+		t.Errorf("order u: BetID = %q, want unknown", order.BetID)
+	}
+	if order, _ := manager.Order("p"); order.Filled != 3 {
+		t.Errorf("order p: Filled = %v, want 3", order.Filled)
+	}
+	if len(server.cancelled) != 1 || server.cancelled[0] != "acked" {
+		t.Errorf("cancelled = %v, want [acked]", server.cancelled)
+	}
+}
+
+func TestOrderManagerRecover(t *testing.T) {
+	tests := []struct {
+		name          string
+		policy        OrphanPolicy
+		wantAdopted   int
+		wantCancelled []string
+		wantIgnored   int
+	}{
+		{name: "adopt", policy: OrphanAdopt, wantAdopted: 1},
+		{name: "cancel", policy: OrphanCancel, wantCancelled: []string{"orphan"}},
+		{name: "ignore", policy: OrphanIgnore, wantIgnored: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newOrderServer(t, []Bet{
+				limitBet("placed", "m1", 10, 0.4),
+				limitBet("elsewhere", "m9", 5, 0.2),
+			})
+			// Orders beyond the first page of open orders must be recovered too.
+			for i := 0; i < 1000; i++ {
+				server.bets = append(server.bets, limitBet(fmt.Sprintf("filler%d", i), "m9", 1, 0.1))
+			}
+			server.bets = append(server.bets, limitBet("orphan", "m1", 5, 0.2))
+			manager := server.manager()
+
+			// The previous run stopped while placing the order, before learning its bet.
+			restored := []ManagedOrder{{Key: "k", ContractID: "m1", Outcome: "YES", Amount: 10, LimitProb: 0.4, State: OrderPending, SubmittedTime: time.Now()}}
+
+			report, err := manager.Recover(restored, tt.policy, []string{"m1"})
+			if err != nil {
+				t.Fatalf("Recover() error = %v", err)
+			}
+
+			if len(report.Restored) != 1 || report.Restored[0].BetID != "placed" || report.Restored[0].State != OrderAcked {
+				t.Errorf("Restored = %+v, want the pending order matched to its bet", report.Restored)
+			}
+			if len(report.Adopted) != tt.wantAdopted || len(report.Ignored) != tt.wantIgnored {
+				t.Errorf("Adopted = %d, Ignored = %d, want %d and %d", len(report.Adopted), len(report.Ignored), tt.wantAdopted, tt.wantIgnored)
+			}
+			if strings.Join(server.cancelled, ",") != strings.Join(tt.wantCancelled, ",") {
+				t.Errorf("cancelled = %v, want %v", server.cancelled, tt.wantCancelled)
+			}
+		})
+	}
+}
+
+func TestFindBetStopsOnceFound(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.URL.RawQuery)
+		page := make([]Bet, 1000)
+		for i := range page {
+			page[i] = Bet{ID: "newer", ContractID: "m1"}
+		}
+		if r.URL.Query().Get("before") != "" {
+			page[10].ID = "target"
+		}
+		json.NewEncoder(w).Encode(page)
+	}))
+	defer server.Close()
+
+	client := NewClient("key")
+	client.BaseURL = server.URL
+
+	since := time.Now().Add(-time.Hour).UnixMilli()
+	bet, err := client.Bet.findBet("u1", "m1", "target", since)
+	if err != nil || bet == nil || bet.ID != "target" {
+		t.Fatalf("findBet() = %v, %v, want the target bet", bet, err)
+	}
+
+	if len(requests) != 2 {
+		t.Errorf("findBet() made %d requests, want 2", len(requests))
+	}
+	if !strings.Contains(requests[0], "afterTime=") {
+		t.Errorf("findBet() request %q is not bounded by afterTime", requests[0])
+	}
+}
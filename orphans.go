@@ -0,0 +1,130 @@
+package manifold
+
+import (
+	"fmt"
+	"time"
+)
+
+// OrphanPolicy determines what OrderManager.Recover does with open orders it does not manage.
+type OrphanPolicy string
+
+const (
+	OrphanAdopt  OrphanPolicy = "ADOPT"  // Start managing the order
+	OrphanCancel OrphanPolicy = "CANCEL" // Cancel the order
+	OrphanIgnore OrphanPolicy = "IGNORE" // Leave the order alone, e.g. if it was placed by hand
+)
+
+// RecoveryReport summarizes what OrderManager.Recover did.
+type RecoveryReport struct {
+	Restored  []ManagedOrder // Orders restored from the previous run, after reconciliation
+	Adopted   []ManagedOrder // Open orders that were not managed and are now
+	Cancelled []Bet          // Open orders that were not managed and were cancelled
+	Ignored   []Bet          // Open orders that were not managed and were left alone
+}
+
+// Recover restores the orders managed by a previous run, for example after a crash, reconciles them against the
+// API, and applies a policy to open orders on the account that are not managed, so that restarts do not leave
+// forgotten orders live.
+//
+// Parameters:
+//   - orders: The orders managed by the previous run, e.g. as returned by Orders and persisted. Optional.
+//   - policy: What to do with open orders that are not managed. Required.
+//   - contractIDs: Only apply the policy to orders on these markets, e.g. the markets the bot trades. If empty, it applies to all markets. Optional.
+//
+// Returns:
+//   - *RecoveryReport: A pointer to the summary of the recovery.
+//   - error: An error object if a request fails or if input validation fails.
+func (m *OrderManager) Recover(orders []ManagedOrder, policy OrphanPolicy, contractIDs []string) (*RecoveryReport, error) {
+	if err := checkOneOf(policy, OrphanAdopt, OrphanCancel, OrphanIgnore); err != nil {
+		return nil, fmt.Errorf("OrderManager: Recover(policy): %w", err)
+	}
+
+	m.mu.Lock()
+	for i := range orders {
+		order := orders[i]
+
+		// An order persisted while pending was being placed when the previous run stopped, so whether it was placed
+		// is unknown and its bet is searched for like any other order in OrderUnknown.
+		if order.State == OrderPending && order.BetID == "" {
+			order.State = OrderUnknown
+		}
+		m.orders[order.Key] = &order
+	}
+	m.mu.Unlock()
+
+	if err := m.Reconcile(); err != nil {
+		return nil, fmt.Errorf("OrderManager: Recover: %w", err)
+	}
+
+	report := new(RecoveryReport)
+	restored := make(map[string]bool, len(orders))
+	for _, order := range orders {
+		restored[order.Key] = true
+	}
+
+	managed := make(map[string]bool)
+	for _, order := range m.Orders() {
+		if order.BetID != "" {
+			managed[order.BetID] = true
+		}
+		if restored[order.Key] {
+			report.Restored = append(report.Restored, order)
+		}
+	}
+
+	scope := make(map[string]bool, len(contractIDs))
+	for _, id := range contractIDs {
+		scope[id] = true
+	}
+
+	open, err := m.client.Bet.openOrders(m.userID)
+	if err != nil {
+		return nil, fmt.Errorf("OrderManager: Recover: %w", err)
+	}
+
+	for i := range open {
+		bet := &open[i]
+		if managed[bet.ID] || bet.LimitProps == nil || (len(scope) > 0 && !scope[bet.ContractID]) {
+			continue
+		}
+
+		switch policy {
+		case OrphanAdopt:
+			report.Adopted = append(report.Adopted, m.adopt(bet))
+		case OrphanCancel:
+			if err := m.client.Bet.Cancel(bet.ID); err != nil {
+				return report, fmt.Errorf("OrderManager: Recover: %w", err)
+			}
+			report.Cancelled = append(report.Cancelled, *bet)
+		case OrphanIgnore:
+			report.Ignored = append(report.Ignored, *bet)
+		}
+	}
+
+	return report, nil
+}
+
+// adopt starts managing an open order placed outside of the manager.
+func (m *OrderManager) adopt(bet *Bet) ManagedOrder {
+	now := time.Now()
+	order := &ManagedOrder{
+		Key:           "adopted-" + bet.ID,
+		ContractID:    bet.ContractID,
+		Outcome:       bet.Outcome,
+		Amount:        bet.LimitProps.OrderAmount,
+		LimitProb:     bet.LimitProps.LimitProb,
+		SubmittedTime: time.UnixMilli(bet.CreatedTime),
+	}
+	if bet.LimitProps.ExpiresAt != nil {
+		expiresAt := time.UnixMilli(*bet.LimitProps.ExpiresAt)
+		order.ExpiresAt = &expiresAt
+	}
+	order.apply(bet, now)
+	order.UpdatedTime = now
+
+	m.mu.Lock()
+	m.orders[order.Key] = order
+	m.mu.Unlock()
+
+	return *order
+}
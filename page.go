@@ -0,0 +1,113 @@
+package manifold
+
+import (
+	"fmt"
+	"time"
+)
+
+// Page is a single page of results from a list endpoint, along with the cursor to fetch the next page.
+type Page[T any] struct {
+	Items []T    // Results on the page
+	Next  string // Cursor to pass as the before (or after, for ascending bets) parameter to fetch the next page, or empty if there are no more results
+	More  bool   // Whether more results likely exist; true if the page was full
+}
+
+// newPage builds a page from the results of a request with the given limit, using cursor to find the next cursor.
+func newPage[T any](items []T, limit int, cursor func(T) string) *Page[T] {
+	page := &Page[T]{Items: items, More: len(items) >= limit && len(items) > 0}
+	if page.More {
+		page.Next = cursor(items[len(items)-1])
+	}
+
+	return page
+}
+
+// UsersPage retrieves a page of users (see Users).
+//
+// Parameters:
+//   - limit: Limits the number of results returned. Must be between 0 and 1000. Defaults to 500. Optional.
+//   - before: Retrieves users before this cursor, usually Page.Next of the previous page. Optional.
+//
+// Returns:
+//   - *Page[User]: A pointer to the page of users.
+//   - error: An error object if the request fails or if input validation fails.
+func (s *UserService) UsersPage(limit *int, before *string) (*Page[User], error) {
+	users, err := s.Users(limit, before)
+	if err != nil {
+		return nil, fmt.Errorf("User: UsersPage: %w", err)
+	}
+
+	return newPage(users, valueOr(limit, 500), func(u User) string { return u.ID }), nil
+}
+
+// MarketsPage retrieves a page of markets (see Markets).
+//
+// Parameters:
+//   - limit: Limits the number of results returned. Must be between 0 and 1000. Defaults to 500. Optional.
+//   - sort: Sorts the results based on one of the allowed sorting options (e.g., "created-time", "updated-time"). Optional.
+//   - order: Specifies the order of the results, either "asc" or "desc". Optional.
+//   - before: Retrieves markets before this cursor, usually Page.Next of the previous page. Optional.
+//   - userID: Filters markets created by a specific user ID. Optional.
+//   - groupID: Filters markets associated with a specific group ID. Optional.
+//
+// Returns:
+//   - *Page[LiteMarket]: A pointer to the page of markets.
+//   - error: An error object if the request fails or if input validation fails.
+func (s *MarketService) MarketsPage(limit *int, sort *string, order *string, before *string, userID *string, groupID *string) (*Page[LiteMarket], error) {
+	markets, err := s.Markets(limit, sort, order, before, userID, groupID)
+	if err != nil {
+		return nil, fmt.Errorf("Market: MarketsPage: %w", err)
+	}
+
+	return newPage(markets, valueOr(limit, 500), func(m LiteMarket) string { return m.ID }), nil
+}
+
+// BetsPage retrieves a page of bets (see Bets). With order "asc", Page.Next is to be passed as the after parameter.
+//
+// Parameters:
+//   - userID: Filter bets by the ID of the user who placed them. Optional.
+//   - username: Filter bets by the username of the user who placed them. Optional.
+//   - contractID: Filter bets by the ID of the contract. Optional.
+//   - contractSlug: Filter bets by the slug of the contract. Optional.
+//   - limit: Limits the number of results returned. Must be between 0 and 1000. Defaults to 1000. Optional.
+//   - before: Retrieves bets before this cursor, usually Page.Next of the previous page. Optional.
+//   - after: Only return bets placed after this cursor. Optional.
+//   - beforeTime: Only return bets placed before this timestamp. Optional.
+//   - afterTime: Only return bets placed after this timestamp. Optional.
+//   - kinds: Filter bets by their kind (e.g., "open-limit"). Optional.
+//   - order: Sort results in "asc" or "desc" order based on placement time. Optional.
+//
+// Returns:
+//   - *Page[Bet]: A pointer to the page of bets.
+//   - error: An error object if the request fails or if input validation fails.
+func (s *BetService) BetsPage(userID *string, username *string, contractID *string, contractSlug *string, limit *int, before *string, after *string, beforeTime *time.Time, afterTime *time.Time, kinds *string, order *string) (*Page[Bet], error) {
+	bets, err := s.Bets(userID, username, contractID, contractSlug, limit, before, after, beforeTime, afterTime, kinds, order)
+	if err != nil {
+		return nil, fmt.Errorf("Bet: BetsPage: %w", err)
+	}
+
+	return newPage(bets, valueOr(limit, 1000), func(b Bet) string { return b.ID }), nil
+}
+
+// CommentsPage retrieves a page of comments (see Comments). Comments are paginated by offset, so Page.Next is the
+// offset of the next page, formatted as a decimal string.
+//
+// Parameters:
+//   - contractID: Filter comments by the ID of the contract. Optional.
+//   - contractSlug: Filter comments by the slug of the contract. Optional.
+//   - limit: Limits the number of results returned. Must be between 0 and 1000. Defaults to 1000. Optional.
+//   - offset: Skips the specified number of comments before returning results. Must be 0 or greater. Optional.
+//   - userID: Filter comments by the ID of the user who posted them. Optional.
+//
+// Returns:
+//   - *Page[Comment]: A pointer to the page of comments.
+//   - error: An error object if the request fails or if input validation fails.
+func (s *CommentService) CommentsPage(contractID *string, contractSlug *string, limit *int, offset *int, userID *string) (*Page[Comment], error) {
+	comments, err := s.Comments(contractID, contractSlug, limit, offset, userID)
+	if err != nil {
+		return nil, fmt.Errorf("Comment: CommentsPage: %w", err)
+	}
+
+	next := valueOr(offset, 0) + len(comments)
+	return newPage(comments, valueOr(limit, 1000), func(Comment) string { return fmt.Sprintf("%d", next) }), nil
+}
@@ -0,0 +1,167 @@
+package manifold
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// PaperBook records the orders a BetService would have placed in paper trading mode. Each order is simulated
+// with a dry run against the live market, so its fills are those it would have got at the time it was placed.
+// Limit orders are not filled later by other traders' bets, as nothing is placed on the real order book.
+type PaperBook struct {
+	mu       sync.Mutex
+	orders   []Bet
+	index    map[string]int
+	budget   float64
+	reserved float64
+}
+
+// NewPaperBook creates a new, empty paper book.
+//
+// Returns:
+//   - *PaperBook: A pointer to the newly created paper book.
+func NewPaperBook() *PaperBook {
+	return &PaperBook{index: make(map[string]int)}
+}
+
+// SetPaper switches the service to paper trading mode, where bets are simulated with a dry run and recorded in
+// the paper book instead of being placed, and cancellations only apply to the book. Read methods are unaffected,
+// except that OrderManager, TrailingOrder and OCOOrder look the orders they placed up in the book, so they track paper
+// orders like placed ones. FillWatcher discovers orders from the live open orders, so it does not see paper orders.
+//
+// Parameters:
+//   - book: The paper book to record orders in. If nil, bets are placed for real again.
+func (s *BetService) SetPaper(book *PaperBook) {
+	s.paper = book
+}
+
+// SetBudget limits the total amount of the orders recorded in the book. Orders that would take the book beyond it
+// are rejected with ErrorPaperBudget before they are simulated.
+//
+// Parameters:
+//   - budget: The largest total amount of the orders. 0 removes the limit.
+func (b *PaperBook) SetBudget(budget float64) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.budget = budget
+}
+
+// reserve sets aside an amount of the budget for an order about to be simulated.
+func (b *PaperBook) reserve(amount float64) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.budget <= 0 {
+		return nil
+	}
+
+	committed := b.reserved + paperInvested(b.orders)
+	if committed+amount > b.budget {
+		return fmt.Errorf("%w: order of %.2f with %.2f of %.2f committed", ErrorPaperBudget, amount, committed, b.budget)
+	}
+	b.reserved += amount
+
+	return nil
+}
+
+// release returns an amount set aside by reserve, once the order has been recorded or has failed.
+func (b *PaperBook) release(amount float64) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.budget > 0 {
+		b.reserved -= amount
+	}
+}
+
+// Orders returns a copy of the recorded orders, in the order they were placed.
+//
+// Returns:
+//   - []Bet: The recorded orders.
+func (b *PaperBook) Orders() []Bet {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	orders := make([]Bet, len(b.orders))
+	copy(orders, b.orders)
+
+	return orders
+}
+
+// Positions returns the shares held per outcome of every market traded in the book.
+//
+// Returns:
+//   - map[string]map[string]float64: The shares held, keyed by answer ID for multiple choice markets and by market ID otherwise, then by outcome.
+func (b *PaperBook) Positions() map[string]map[string]float64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	positions := make(map[string]map[string]float64)
+	for _, order := range b.orders {
+		key := order.ContractID
+		if order.AnswerID != nil {
+			key = *order.AnswerID
+		}
+
+		if positions[key] == nil {
+			positions[key] = make(map[string]float64)
+		}
+		positions[key][order.Outcome] += order.Shares
+	}
+
+	return positions
+}
+
+// record assigns a local ID to a simulated order and adds it to the book.
+func (b *PaperBook) record(bet *Bet) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	bet.ID = fmt.Sprintf("paper-%d", len(b.orders)+1)
+	if bet.CreatedTime == 0 {
+		bet.CreatedTime = time.Now().UnixMilli()
+	}
+
+	b.index[bet.ID] = len(b.orders)
+	b.orders = append(b.orders, *bet)
+}
+
+// lookup returns a copy of an order in the book, reporting false if the ID is not a paper order.
+func (b *PaperBook) lookup(id string) (*Bet, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	i, ok := b.index[id]
+	if !ok {
+		return nil, false
+	}
+
+	bet := b.orders[i]
+	if bet.LimitProps != nil {
+		props := *bet.LimitProps
+		bet.LimitProps = &props
+	}
+
+	return &bet, true
+}
+
+// cancel cancels an unfilled limit order in the book.
+func (b *PaperBook) cancel(id string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	i, ok := b.index[id]
+	if !ok {
+		return fmt.Errorf("unknown paper order %s", id)
+	}
+
+	order := &b.orders[i]
+	if order.LimitProps == nil || order.LimitProps.IsFilled {
+		return fmt.Errorf("paper order %s is not an open limit order", id)
+	}
+	order.LimitProps.IsCancelled = true
+
+	return nil
+}
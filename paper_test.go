@@ -0,0 +1,101 @@
+package manifold
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestPaperBudget(t *testing.T) {
+	var simulated int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]string
+		decodeBody(t, r, &body)
+		simulated++
+		w.Write([]byte(`{"contractId":"m","outcome":"YES","amount":` + body["amount"] + `,"shares":10}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("key")
+	client.BaseURL = server.URL
+	book := NewPaperBook()
+	book.SetBudget(10)
+	client.Bet.SetPaper(book)
+
+	tests := []struct {
+		name    string
+		amount  float64
+		wantErr bool
+	}{
+		{"within budget", 6, false},
+		{"beyond budget", 5, true},
+		{"up to budget", 4, false},
+		{"budget spent", 1, true},
+	}
+
+	for _, tt := range tests {
+		_, err := client.Bet.Create(tt.amount, "m", ptr("YES"), nil, nil, nil)
+		if tt.wantErr != errors.Is(err, ErrorPaperBudget) {
+			t.Fatalf("%s: Create(%v) error = %v, want budget error %v", tt.name, tt.amount, err, tt.wantErr)
+		}
+	}
+
+	if simulated != 2 {
+		t.Errorf("simulated %d orders, want 2", simulated)
+	}
+	if got := paperInvested(book.Orders()); got != 10 {
+		t.Errorf("invested %v, want 10", got)
+	}
+}
+
+func TestPaperOrdersAreFoundInBook(t *testing.T) {
+	listed := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/bets" {
+			listed++
+			w.Write([]byte(`[]`))
+			return
+		}
+		w.Write([]byte(`{"contractId":"m","outcome":"YES","amount":0,"shares":0,"limitProb":0.4,"orderAmount":10,"limitProps":{"isFilled":false,"isCancelled":false}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("key")
+	client.BaseURL = server.URL
+	client.Bet.SetPaper(NewPaperBook())
+
+	bet, err := client.Bet.Create(10, "m", ptr("YES"), ptr(0.4), nil, nil)
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		cancel    bool
+		cancelled bool
+	}{
+		{"open", false, false},
+		{"cancelled", true, true},
+	}
+
+	for _, tt := range tests {
+		if tt.cancel {
+			if err := client.Bet.Cancel(bet.ID); err != nil {
+				t.Fatalf("%s: Cancel() error = %v", tt.name, err)
+			}
+		}
+
+		found, err := client.Bet.findBet("u", "m", bet.ID, bet.CreatedTime)
+		if err != nil || found == nil {
+			t.Fatalf("%s: findBet() = %v, %v; want the paper order", tt.name, found, err)
+		}
+		if found.LimitProps == nil || found.LimitProps.IsCancelled != tt.cancelled {
+			t.Errorf("%s: findBet() limit props = %+v, want cancelled %v", tt.name, found.LimitProps, tt.cancelled)
+		}
+	}
+
+	if listed != 0 {
+		t.Errorf("listed bets %d times, want paper orders found without the API", listed)
+	}
+}
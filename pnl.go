@@ -0,0 +1,48 @@
+package manifold
+
+// PnLSplit is the profit of a position split into realized and unrealized parts.
+type PnLSplit struct {
+	ContractID string  // ID of the market
+	AnswerID   *string // ID of the answer, for multiple choice markets (optional)
+	Realized   float64 // Profit locked in by sales, redemptions, and resolution
+	Unrealized float64 // Mark-to-market gain on the shares still held
+	Total      float64 // Realized + Unrealized, equal to ContractMetric.Profit
+}
+
+// PortfolioPnL is the profit of a set of positions split into realized and unrealized parts.
+type PortfolioPnL struct {
+	Positions  []PnLSplit // Split of every position, in the order given
+	Realized   float64    // Total realized profit
+	Unrealized float64    // Total unrealized profit
+	Total      float64    // Total profit
+}
+
+// SplitPnL separates the realized and unrealized profit of positions. ContractMetric.Profit conflates both:
+// the unrealized part is the current value of the shares held minus what they cost, and the rest has been realized.
+// Positions in resolved markets are fully realized.
+//
+// Parameters:
+//   - metrics: The positions to split. Required.
+//   - resolved: The IDs of markets that have resolved. Optional.
+//
+// Returns:
+//   - PortfolioPnL: The split of every position and the portfolio totals.
+func SplitPnL(metrics []ContractMetric, resolved map[string]bool) PortfolioPnL {
+	portfolio := PortfolioPnL{Positions: make([]PnLSplit, 0, len(metrics))}
+
+	for _, metric := range metrics {
+		split := PnLSplit{ContractID: metric.ContractID, AnswerID: metric.AnswerID, Total: metric.Profit}
+
+		if metric.HasShares && !resolved[metric.ContractID] {
+			split.Unrealized = metric.Payout - metric.Invested
+		}
+		split.Realized = split.Total - split.Unrealized
+
+		portfolio.Positions = append(portfolio.Positions, split)
+		portfolio.Realized += split.Realized
+		portfolio.Unrealized += split.Unrealized
+		portfolio.Total += split.Total
+	}
+
+	return portfolio
+}
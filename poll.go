@@ -0,0 +1,84 @@
+package manifold
+
+import (
+	"fmt"
+	"sort"
+)
+
+// PollResult is the result of a single poll option.
+type PollResult struct {
+	PollOption
+
+	Share float64 // Fraction of all votes cast for the option, or 0 if no votes have been cast
+}
+
+// PollResults are the current results of a poll.
+type PollResults struct {
+	ContractID string       // ID of the market
+	Question   string       // Question of the poll
+	TotalVotes int          // Total number of votes cast
+	Options    []PollResult // Results of every option, ordered by votes, highest first, then by index
+}
+
+// Leader returns the option with the most votes, or nil if no votes have been cast or the lead is tied.
+func (r *PollResults) Leader() *PollResult {
+	if len(r.Options) == 0 || r.Options[0].Votes == 0 || (len(r.Options) > 1 && r.Options[1].Votes == r.Options[0].Votes) {
+		return nil
+	}
+
+	return &r.Options[0]
+}
+
+// PollResults returns the current results of a poll market.
+//
+// Returns:
+//   - *PollResults: A pointer to the results.
+//   - error: An error object if the market is not a poll.
+func (m *FullMarket) PollResults() (*PollResults, error) {
+	if m.OutcomeType != "POLL" || m.Options == nil {
+		return nil, fmt.Errorf("PollResults: market %s is not a poll", m.ID)
+	}
+
+	results := &PollResults{ContractID: m.ID, Question: m.Question, Options: make([]PollResult, 0, len(*m.Options))}
+	for _, option := range *m.Options {
+		results.TotalVotes += option.Votes
+		results.Options = append(results.Options, PollResult{PollOption: option})
+	}
+
+	for i := range results.Options {
+		if results.TotalVotes > 0 {
+			results.Options[i].Share = float64(results.Options[i].Votes) / float64(results.TotalVotes)
+		}
+	}
+
+	sort.SliceStable(results.Options, func(i, j int) bool {
+		if results.Options[i].Votes != results.Options[j].Votes {
+			return results.Options[i].Votes > results.Options[j].Votes
+		}
+		return results.Options[i].Index < results.Options[j].Index
+	})
+
+	return results, nil
+}
+
+// Poll retrieves the current results of a poll market.
+//
+// Parameters:
+//   - id: The ID of the poll market. Required.
+//
+// Returns:
+//   - *PollResults: A pointer to the results.
+//   - error: An error object if the request fails, the response cannot be parsed, or the market is not a poll.
+func (s *MarketService) Poll(id string) (*PollResults, error) {
+	market, err := s.Market(id)
+	if err != nil {
+		return nil, fmt.Errorf("Market: Poll: %w", err)
+	}
+
+	results, err := market.PollResults()
+	if err != nil {
+		return nil, fmt.Errorf("Market: %w", err)
+	}
+
+	return results, nil
+}
@@ -0,0 +1,146 @@
+package manifold
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+)
+
+// Portfolio is the set of open positions a user holds, along with the markets they are in.
+type Portfolio struct {
+	UserID    string                 // ID of the user
+	Positions []ContractMetric       // Open positions, ordered by market and answer ID
+	Markets   map[string]*FullMarket // Markets of the positions, keyed by market ID
+}
+
+// TopicExposure is the part of a portfolio in markets tagged with a single topic.
+type TopicExposure struct {
+	Topic     string  // Slug of the group, or empty for markets without groups
+	Positions int     // Number of positions in the topic
+	Exposure  float64 // Current value of the positions
+	Invested  float64 // Amount invested in the positions
+	Profit    float64 // Profit of the positions
+	Share     float64 // Fraction of the portfolio's total exposure
+}
+
+// Exposure returns the total current value of the positions in the portfolio.
+func (p *Portfolio) Exposure() float64 {
+	var exposure float64
+	for _, position := range p.Positions {
+		exposure += position.Payout
+	}
+
+	return exposure
+}
+
+// Topics breaks the portfolio down by the group slugs of its markets. A market tagged with several groups
+// counts towards each of them, so the shares of all topics may add up to more than one.
+//
+// Returns:
+//   - []TopicExposure: The breakdown of every topic, ordered by exposure, highest first.
+func (p *Portfolio) Topics() []TopicExposure {
+	total := p.Exposure()
+	byTopic := make(map[string]*TopicExposure)
+
+	for _, position := range p.Positions {
+		topics := []string{""}
+		if market, ok := p.Markets[position.ContractID]; ok && market.GroupSlugs != nil && len(*market.GroupSlugs) > 0 {
+			topics = *market.GroupSlugs
+		}
+
+		for _, topic := range topics {
+			exposure, ok := byTopic[topic]
+			if !ok {
+				exposure = &TopicExposure{Topic: topic}
+				byTopic[topic] = exposure
+			}
+
+			exposure.Positions++
+			exposure.Exposure += position.Payout
+			exposure.Invested += position.Invested
+			exposure.Profit += position.Profit
+		}
+	}
+
+	topics := make([]TopicExposure, 0, len(byTopic))
+	for _, exposure := range byTopic {
+		if total > 0 {
+			exposure.Share = exposure.Exposure / total
+		}
+		topics = append(topics, *exposure)
+	}
+
+	sort.Slice(topics, func(i, j int) bool {
+		if topics[i].Exposure != topics[j].Exposure {
+			return topics[i].Exposure > topics[j].Exposure
+		}
+		return topics[i].Topic < topics[j].Topic
+	})
+
+	return topics
+}
+
+// Portfolio retrieves the open positions of a user in unresolved markets, found from the markets they have bet on.
+//
+// Parameters:
+//   - userID: The ID of the user. Required.
+//
+// Returns:
+//   - *Portfolio: A pointer to the portfolio.
+//   - error: An error object if a request fails or if the response cannot be parsed.
+func (s *UserService) Portfolio(userID string) (*Portfolio, error) {
+	bets, err := s.client.Bet.allBets(&userID, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("User: Portfolio: %w", err)
+	}
+
+	portfolio := &Portfolio{UserID: userID, Markets: make(map[string]*FullMarket)}
+	fetched := make(map[string]bool)
+
+	for _, bet := range bets {
+		if fetched[bet.ContractID] {
+			continue
+		}
+		fetched[bet.ContractID] = true
+
+		market, err := s.client.Market.Market(bet.ContractID)
+		if err != nil {
+			return nil, fmt.Errorf("User: Portfolio: %w", err)
+		}
+		if market.IsResolved {
+			continue
+		}
+
+		positions, err := s.client.Market.userPositions(market.ID, userID)
+		if err != nil {
+			return nil, fmt.Errorf("User: Portfolio: %w", err)
+		}
+
+		for _, position := range positions {
+			if position.UserID == userID && position.HasShares {
+				portfolio.Positions = append(portfolio.Positions, position)
+				portfolio.Markets[market.ID] = market
+			}
+		}
+	}
+
+	sort.Slice(portfolio.Positions, func(i, j int) bool {
+		a, b := portfolio.Positions[i], portfolio.Positions[j]
+		if a.ContractID != b.ContractID {
+			return a.ContractID < b.ContractID
+		}
+		return a.AnswerID != nil && (b.AnswerID == nil || *a.AnswerID < *b.AnswerID)
+	})
+
+	return portfolio, nil
+}
+
+// userPositions retrieves the positions of a single user in a market.
+func (s *MarketService) userPositions(id string, userID string) ([]ContractMetric, error) {
+	positions, err := GetJSON[[]ContractMetric](s.client, fmt.Sprintf("/market/%s/positions", url.PathEscape(id)), map[string]string{"userId": userID})
+	if err != nil {
+		return nil, fmt.Errorf("Market: userPositions: %w", err)
+	}
+
+	return positions, nil
+}
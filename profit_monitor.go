@@ -0,0 +1,178 @@
+package manifold
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+// ProfitAlertKind identifies the kind of alert reported by a ProfitMonitor.
+type ProfitAlertKind string
+
+const (
+	ProfitThresholdCrossed ProfitAlertKind = "PROFIT_THRESHOLD_CROSSED" // A user's profit crossed a threshold
+	ProfitLeaderChanged    ProfitAlertKind = "PROFIT_LEADER_CHANGED"    // A different watched user now has the highest profit
+)
+
+// ProfitPeriod is a period of the cached profit of a user.
+type ProfitPeriod string
+
+const (
+	ProfitDaily   ProfitPeriod = "daily"
+	ProfitWeekly  ProfitPeriod = "weekly"
+	ProfitMonthly ProfitPeriod = "monthly"
+	ProfitAllTime ProfitPeriod = "allTime"
+)
+
+// ProfitAlert reports a profit milestone or leader change among watched users.
+type ProfitAlert struct {
+	Kind      ProfitAlertKind // Kind of alert
+	Period    ProfitPeriod    // Profit period the alert is for
+	User      *User           // User the alert is about; the new leader for leader changes
+	Profit    float64         // Current profit of the user over the period
+	Threshold float64         // Threshold crossed, for threshold alerts
+	Up        bool            // Whether the threshold was crossed upwards, for threshold alerts
+}
+
+// ProfitMonitor polls a set of users and alerts when their cached profit crosses thresholds, or when the leader
+// among them changes.
+type ProfitMonitor struct {
+	client     *Client
+	period     ProfitPeriod
+	thresholds []float64
+	onAlert    func(ProfitAlert)
+
+	mu      sync.Mutex
+	userIDs []string
+	profits map[string]float64
+	leader  string
+}
+
+// NewProfitMonitor creates a new profit monitor. The first Check sets the baseline, so no alerts are emitted for it.
+//
+// Parameters:
+//   - client: The client used to poll users. Required.
+//   - userIDs: The IDs of the users to watch. Required.
+//   - period: The profit period to watch. Required.
+//   - thresholds: The profit levels to alert on crossing. Optional.
+//   - onAlert: Called for every alert. Required.
+//
+// Returns:
+//   - *ProfitMonitor: A pointer to the newly created monitor.
+//   - error: An error object if input validation fails.
+func NewProfitMonitor(client *Client, userIDs []string, period ProfitPeriod, thresholds []float64, onAlert func(ProfitAlert)) (*ProfitMonitor, error) {
+	if err := checkOneOf(period, ProfitDaily, ProfitWeekly, ProfitMonthly, ProfitAllTime); err != nil {
+		return nil, fmt.Errorf("NewProfitMonitor(period): %w", err)
+	}
+
+	sorted := append([]float64(nil), thresholds...)
+	sort.Float64s(sorted)
+
+	return &ProfitMonitor{
+		client:     client,
+		period:     period,
+		thresholds: sorted,
+		onAlert:    onAlert,
+		userIDs:    append([]string(nil), userIDs...),
+		profits:    make(map[string]float64),
+	}, nil
+}
+
+// profit returns the cached profit of a user over a period.
+func (p ProfitPeriod) profit(user *User) float64 {
+	switch p {
+	case ProfitDaily:
+		return user.ProfitCached.Daily
+	case ProfitWeekly:
+		return user.ProfitCached.Weekly
+	case ProfitMonthly:
+		return user.ProfitCached.Monthly
+	default:
+		return user.ProfitCached.AllTime
+	}
+}
+
+// Check polls every watched user once and emits alerts for thresholds crossed and leader changes since the previous poll.
+//
+// Returns:
+//   - error: An error object if a user could not be polled. No alerts are emitted for a partial poll.
+func (m *ProfitMonitor) Check() error {
+	m.mu.Lock()
+	userIDs := append([]string(nil), m.userIDs...)
+	m.mu.Unlock()
+
+	users := make([]*User, 0, len(userIDs))
+	for _, id := range userIDs {
+		user, err := m.client.User.ID(id)
+		if err != nil {
+			return fmt.Errorf("ProfitMonitor: Check: %w", err)
+		}
+		users = append(users, user)
+	}
+
+	var (
+		alerts []ProfitAlert
+		leader *User
+	)
+
+	m.mu.Lock()
+	for _, user := range users {
+		profit := m.period.profit(user)
+		previous, seen := m.profits[user.ID]
+		m.profits[user.ID] = profit
+
+		if leader == nil || profit > m.period.profit(leader) {
+			leader = user
+		}
+
+		if !seen {
+			continue
+		}
+
+		for _, threshold := range m.thresholds {
+			if previous < threshold && profit >= threshold {
+				alerts = append(alerts, ProfitAlert{Kind: ProfitThresholdCrossed, Period: m.period, User: user, Profit: profit, Threshold: threshold, Up: true})
+			} else if previous >= threshold && profit < threshold {
+				alerts = append(alerts, ProfitAlert{Kind: ProfitThresholdCrossed, Period: m.period, User: user, Profit: profit, Threshold: threshold})
+			}
+		}
+	}
+
+	if leader != nil {
+		if m.leader != "" && m.leader != leader.ID {
+			alerts = append(alerts, ProfitAlert{Kind: ProfitLeaderChanged, Period: m.period, User: leader, Profit: m.period.profit(leader)})
+		}
+		m.leader = leader.ID
+	}
+	m.mu.Unlock()
+
+	for _, alert := range alerts {
+		m.onAlert(alert)
+	}
+
+	return nil
+}
+
+// Run calls Check at the given interval until stop is closed. Errors from Check are passed to onError.
+//
+// Parameters:
+//   - interval: The time between checks. Required.
+//   - stop: A channel that stops the monitor when closed. Required.
+//   - onError: Called with every error returned by Check. Optional.
+func (m *ProfitMonitor) Run(interval time.Duration, stop <-chan struct{}, onError func(error)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := m.Check(); err != nil && onError != nil {
+			onError(err)
+		}
+
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package manifold
+
+import (
+	"fmt"
+	"sort"
+)
+
+// RankedAnswer is an answer of a multiple choice market, ranked by probability.
+type RankedAnswer struct {
+	ID          string  // ID of the answer
+	Text        string  // Text of the answer
+	Rank        int     // Position of the answer, starting from 1 for the most likely answer
+	Probability float64 // Probability of the answer as reported by the market
+	Normalized  float64 // Probability normalized so that all answers sum to one
+	Cumulative  float64 // Sum of the normalized probabilities of this answer and every higher ranked answer
+}
+
+// AnswerRanking is the ranked list of answers of a multiple choice market.
+type AnswerRanking struct {
+	Answers []RankedAnswer // Answers ordered from most to least likely
+	Winner  RankedAnswer   // The implied winner, i.e. the most likely answer
+	Margin  float64        // Normalized probability lead of the implied winner over the runner-up
+}
+
+// RankAnswers ranks the answers of a multiple choice market by probability, normalizing them to sum to one.
+//
+// Parameters:
+//   - market: The multiple choice market to rank the answers of. Required.
+//
+// Returns:
+//   - *AnswerRanking: A pointer to the ranking of the answers.
+//   - error: An error object if the market has no answers or their probabilities sum to zero.
+func RankAnswers(market *FullMarket) (*AnswerRanking, error) {
+	if market.Answers == nil || len(*market.Answers) == 0 {
+		return nil, fmt.Errorf("RankAnswers: market has no answers")
+	}
+
+	answers := make([]RankedAnswer, 0, len(*market.Answers))
+	var total float64
+	for _, answer := range *market.Answers {
+		answers = append(answers, RankedAnswer{ID: answer.ID, Text: answer.Text, Probability: answer.Probability})
+		total += answer.Probability
+	}
+
+	if total <= 0 {
+		return nil, fmt.Errorf("RankAnswers: answer probabilities sum to zero")
+	}
+
+	sort.SliceStable(answers, func(i, j int) bool {
+		return answers[i].Probability > answers[j].Probability
+	})
+
+	var cumulative float64
+	for i := range answers {
+		answers[i].Rank = i + 1
+		answers[i].Normalized = answers[i].Probability / total
+		cumulative += answers[i].Normalized
+		answers[i].Cumulative = cumulative
+	}
+
+	ranking := &AnswerRanking{Answers: answers, Winner: answers[0], Margin: answers[0].Normalized}
+	if len(answers) > 1 {
+		ranking.Margin -= answers[1].Normalized
+	}
+
+	return ranking, nil
+}
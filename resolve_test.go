@@ -0,0 +1,113 @@
+package manifold
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func newResolveServer(t *testing.T, market string) (*Client, *int) {
+	t.Helper()
+
+	resolved := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/market/m":
+			w.Write([]byte(market))
+		case "/market/m/resolve":
+			resolved++
+			w.Write([]byte(`{"id":"m"}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	client := NewClient("key")
+	client.BaseURL = server.URL
+	client.RateLimitRetries = 0
+
+	return client, &resolved
+}
+
+func TestResolveFreeResponseChecked(t *testing.T) {
+	market := `{"id":"m","answers":[{"id":"a0","index":0,"prob":0.8},{"id":"a1","index":1,"prob":0.02}]}`
+
+	tests := []struct {
+		name        string
+		outcome     string
+		resolutions []Resolution
+		force       bool
+		wantErr     bool
+	}{
+		{name: "likely answer", outcome: "MKT", resolutions: []Resolution{{Answer: 0, Pct: 100}}},
+		{name: "unlikely answer", outcome: "MKT", resolutions: []Resolution{{Answer: 0, Pct: 50}, {Answer: 1, Pct: 50}}, wantErr: true},
+		{name: "unlikely answer with no payout", outcome: "MKT", resolutions: []Resolution{{Answer: 0, Pct: 100}, {Answer: 1, Pct: 0}}},
+		{name: "unknown answer", outcome: "MKT", resolutions: []Resolution{{Answer: 5, Pct: 100}}, wantErr: true},
+		{name: "unlikely answer ID", outcome: "a1", wantErr: true},
+		{name: "forced", outcome: "MKT", resolutions: []Resolution{{Answer: 1, Pct: 100}}, force: true},
+		{name: "cancel", outcome: "CANCEL"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, resolved := newResolveServer(t, market)
+
+			_, err := client.Market.ResolveFreeResponseChecked("m", tt.outcome, tt.resolutions, 0.1, tt.force)
+			if tt.wantErr {
+				if !errors.Is(err, ErrorResolutionCheckFailed) {
+					t.Errorf("ResolveFreeResponseChecked() error = %v, want ErrorResolutionCheckFailed", err)
+				}
+				if *resolved != 0 {
+					t.Error("market was resolved despite the failed check")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ResolveFreeResponseChecked() error = %v", err)
+			}
+			if *resolved != 1 {
+				t.Errorf("resolved %d times, want 1", *resolved)
+			}
+		})
+	}
+}
+
+func TestResolvePseudoNumericChecked(t *testing.T) {
+	market := `{"id":"m","value":40,"min":0,"max":200}`
+
+	tests := []struct {
+		name    string
+		value   float64
+		force   bool
+		wantErr bool
+	}{
+		{name: "near the current value", value: 55},
+		{name: "far from the current value", value: 150, wantErr: true},
+		{name: "forced", value: 150, force: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, resolved := newResolveServer(t, market)
+
+			_, err := client.Market.ResolvePseudoNumericChecked("m", tt.value, 50, 0.1, tt.force)
+			if tt.wantErr {
+				if !errors.Is(err, ErrorResolutionCheckFailed) {
+					t.Errorf("ResolvePseudoNumericChecked() error = %v, want ErrorResolutionCheckFailed", err)
+				}
+				if *resolved != 0 {
+					t.Error("market was resolved despite the failed check")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ResolvePseudoNumericChecked() error = %v", err)
+			}
+			if *resolved != 1 {
+				t.Errorf("resolved %d times, want 1", *resolved)
+			}
+		})
+	}
+}
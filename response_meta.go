@@ -0,0 +1,67 @@
+package manifold
+
+import (
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// ResponseMeta is metadata about an API response, useful for support tickets and adaptive throttling.
+type ResponseMeta struct {
+	Endpoint           string      // Endpoint the request was sent to
+	StatusCode         int         // HTTP status code of the response
+	RequestID          string      // ID of the request from X-Request-Id or X-Cloud-Trace-Context, if sent
+	RateLimitRemaining *int        // Requests remaining in the rate limit window, from X-RateLimit-Remaining (optional)
+	RateLimitReset     time.Time   // Time the rate limit window resets, from X-RateLimit-Reset, or the zero time if not sent
+	Time               time.Time   // Time the response was received
+	Header             http.Header // All headers of the response
+}
+
+// responseMetaState holds the metadata of the latest response received by a client.
+type responseMetaState struct {
+	mu   sync.Mutex
+	last *ResponseMeta
+}
+
+// LastResponse returns the metadata of the latest response received by the client. With concurrent requests,
+// it may belong to another goroutine's request; use OnResponse to see every response.
+//
+// Returns:
+//   - *ResponseMeta: A pointer to the metadata, or nil if no response has been received yet.
+func (c *Client) LastResponse() *ResponseMeta {
+	c.responseMeta.mu.Lock()
+	defer c.responseMeta.mu.Unlock()
+
+	return c.responseMeta.last
+}
+
+// recordResponse extracts the metadata of a response, stores it as the latest, and passes it to OnResponse.
+func (c *Client) recordResponse(endpoint string, resp *http.Response, now time.Time) *ResponseMeta {
+	meta := &ResponseMeta{
+		Endpoint:       endpoint,
+		StatusCode:     resp.StatusCode,
+		RequestID:      resp.Header.Get("X-Request-Id"),
+		RateLimitReset: parseRateLimitReset(resp.Header.Get("X-RateLimit-Reset"), now),
+		Time:           now,
+		Header:         resp.Header,
+	}
+
+	if meta.RequestID == "" {
+		meta.RequestID = resp.Header.Get("X-Cloud-Trace-Context")
+	}
+
+	if remaining, err := strconv.Atoi(resp.Header.Get("X-RateLimit-Remaining")); err == nil {
+		meta.RateLimitRemaining = &remaining
+	}
+
+	c.responseMeta.mu.Lock()
+	c.responseMeta.last = meta
+	c.responseMeta.mu.Unlock()
+
+	if c.OnResponse != nil {
+		c.OnResponse(meta)
+	}
+
+	return meta
+}
@@ -0,0 +1,136 @@
+package manifold
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// EventRisk is the risk of the positions held on a single event, which may span several correlated markets.
+type EventRisk struct {
+	Markets      []string // IDs of the markets the positions are in
+	Positions    int      // Number of positions on the event
+	Exposure     float64  // Current value of the positions
+	WorstLoss    float64  // Loss of value if the event resolves against the positions
+	WorstOutcome string   // Resolution that causes the worst loss ("YES" or "NO")
+}
+
+// PortfolioRisk summarizes the concentration of a portfolio, accounting for correlated markets.
+type PortfolioRisk struct {
+	Events             []EventRisk // Risk of every event, ordered by worst loss, highest first
+	EffectivePositions float64     // Effective number of independent positions, weighted by worst loss
+	TotalWorstLoss     float64     // Sum of the worst losses of all events
+}
+
+// AssessRisk estimates the concentration of a set of positions. Positions in markets of the same group are treated as
+// a single event that resolves the same way everywhere, so YES shares in one market offset NO shares in another,
+// while every other position is treated as independent. The effective number of independent positions is the inverse
+// Herfindahl index of the events' worst losses: N equally risky events give N, and one dominant event gives close to 1.
+//
+// Parameters:
+//   - positions: The positions held by a single user. Required.
+//   - groups: Sets of market IDs that are considered to represent the same event. Optional.
+//
+// Returns:
+//   - PortfolioRisk: The risk of the portfolio.
+func AssessRisk(positions []ContractMetric, groups [][]string) PortfolioRisk {
+	groupOf := make(map[string]int)
+	for i, group := range groups {
+		for _, id := range group {
+			groupOf[id] = i
+		}
+	}
+
+	type event struct {
+		risk       EventRisk
+		yes, no    float64
+		seenMarket map[string]bool
+	}
+
+	events := make(map[string]*event)
+	order := make([]string, 0)
+	for _, position := range positions {
+		key := position.ContractID
+		if position.AnswerID != nil {
+			key += "/" + *position.AnswerID
+		} else if i, ok := groupOf[position.ContractID]; ok {
+			key = fmt.Sprintf("group/%d", i)
+		}
+
+		e, ok := events[key]
+		if !ok {
+			e = &event{seenMarket: make(map[string]bool)}
+			events[key] = e
+			order = append(order, key)
+		}
+
+		if !e.seenMarket[position.ContractID] {
+			e.seenMarket[position.ContractID] = true
+			e.risk.Markets = append(e.risk.Markets, position.ContractID)
+		}
+
+		e.risk.Positions++
+		e.risk.Exposure += position.Payout
+		e.yes += position.TotalShares["YES"]
+		e.no += position.TotalShares["NO"]
+	}
+
+	var risk PortfolioRisk
+	var sumSquares float64
+	for _, key := range order {
+		e := events[key]
+
+		lossYes, lossNo := e.risk.Exposure-e.yes, e.risk.Exposure-e.no
+		e.risk.WorstOutcome = "YES"
+		e.risk.WorstLoss = lossYes
+		if lossNo > lossYes {
+			e.risk.WorstOutcome = "NO"
+			e.risk.WorstLoss = lossNo
+		}
+		e.risk.WorstLoss = math.Max(e.risk.WorstLoss, 0)
+
+		sort.Strings(e.risk.Markets)
+		risk.Events = append(risk.Events, e.risk)
+		risk.TotalWorstLoss += e.risk.WorstLoss
+		sumSquares += e.risk.WorstLoss * e.risk.WorstLoss
+	}
+
+	if sumSquares > 0 {
+		risk.EffectivePositions = risk.TotalWorstLoss * risk.TotalWorstLoss / sumSquares
+	}
+
+	sort.SliceStable(risk.Events, func(i, j int) bool {
+		return risk.Events[i].WorstLoss > risk.Events[j].WorstLoss
+	})
+
+	return risk
+}
+
+// LargestLoss returns the event with the largest worst-case loss, or nil if there are no events.
+func (r PortfolioRisk) LargestLoss() *EventRisk {
+	if len(r.Events) == 0 {
+		return nil
+	}
+
+	return &r.Events[0]
+}
+
+// Risk estimates the concentration of the portfolio (see AssessRisk). Positions in stonk markets are excluded,
+// as they never resolve and so have no resolution to lose on.
+//
+// Parameters:
+//   - groups: Sets of market IDs that are considered to represent the same event. Optional.
+//
+// Returns:
+//   - PortfolioRisk: The risk of the portfolio.
+func (p *Portfolio) Risk(groups [][]string) PortfolioRisk {
+	positions := make([]ContractMetric, 0, len(p.Positions))
+	for _, position := range p.Positions {
+		if market, ok := p.Markets[position.ContractID]; ok && market.IsStonk() {
+			continue
+		}
+		positions = append(positions, position)
+	}
+
+	return AssessRisk(positions, groups)
+}
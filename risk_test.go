@@ -0,0 +1,51 @@
+package manifold
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestAssessRisk(t *testing.T) {
+	position := func(id string, outcome string, shares float64, payout float64) ContractMetric {
+		return ContractMetric{ContractID: id, Payout: payout, TotalShares: map[string]float64{outcome: shares}}
+	}
+	answer := "x"
+	multi := position("m", "YES", 10, 5)
+	multi.AnswerID = &answer
+
+	tests := []struct {
+		name      string
+		positions []ContractMetric
+		groups    [][]string
+		want      []EventRisk
+		effective float64
+	}{
+		{"no positions", nil, nil, nil, 0},
+		{"independent positions", []ContractMetric{position("c", "NO", 50, 20), position("a", "YES", 100, 60)}, nil, []EventRisk{
+			{Markets: []string{"a"}, Positions: 1, Exposure: 60, WorstLoss: 60, WorstOutcome: "NO"},
+			{Markets: []string{"c"}, Positions: 1, Exposure: 20, WorstLoss: 20, WorstOutcome: "YES"},
+		}, 1.6},
+		{"grouped positions offset", []ContractMetric{position("a", "YES", 100, 60), position("b", "NO", 100, 40)}, [][]string{{"a", "b"}}, []EventRisk{
+			{Markets: []string{"a", "b"}, Positions: 2, Exposure: 100, WorstLoss: 0, WorstOutcome: "YES"},
+		}, 0},
+		{"grouped positions add up", []ContractMetric{position("b", "YES", 50, 30), position("a", "YES", 100, 60)}, [][]string{{"a", "b"}}, []EventRisk{
+			{Markets: []string{"a", "b"}, Positions: 2, Exposure: 90, WorstLoss: 90, WorstOutcome: "NO"},
+		}, 1},
+		{"answers are separate events", []ContractMetric{multi, position("m", "YES", 10, 5)}, [][]string{{"m"}}, []EventRisk{
+			{Markets: []string{"m"}, Positions: 1, Exposure: 5, WorstLoss: 5, WorstOutcome: "NO"},
+			{Markets: []string{"m"}, Positions: 1, Exposure: 5, WorstLoss: 5, WorstOutcome: "NO"},
+		}, 2},
+	}
+
+	for _, tt := range tests {
+		risk := AssessRisk(tt.positions, tt.groups)
+
+		if !reflect.DeepEqual(risk.Events, tt.want) {
+			t.Errorf("%s: events = %+v, want %+v", tt.name, risk.Events, tt.want)
+		}
+		if math.Abs(risk.EffectivePositions-tt.effective) > 1e-9 {
+			t.Errorf("%s: effective positions = %v, want %v", tt.name, risk.EffectivePositions, tt.effective)
+		}
+	}
+}
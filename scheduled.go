@@ -0,0 +1,93 @@
+package manifold
+
+import (
+	"fmt"
+	"time"
+)
+
+// ScheduledBet describes a bet to be placed once a trigger condition is met.
+// It is serializable to JSON, so pending bets can be persisted by the caller and resumed after a restart.
+type ScheduledBet struct {
+	ContractID  string         `json:"contractId"`            // ID of the market to bet on
+	Amount      float64        `json:"amount"`                // Amount of the bet
+	Outcome     string         `json:"outcome"`               // Outcome to buy ("YES" or "NO")
+	LimitProb   *float64       `json:"limitProb,omitempty"`   // Probability threshold, to place a limit order instead (optional)
+	At          *time.Time     `json:"at,omitempty"`          // Place the bet at or after this time (optional)
+	BeforeClose *time.Duration `json:"beforeClose,omitempty"` // Place the bet this long before the market closes (optional)
+}
+
+// Due reports whether the bet should be placed now, given the current state of its market.
+// Bets are only due while the market is open, so a bet on a closed market waits for it to reopen.
+// All configured triggers must also be met; a bet with no triggers is due as soon as the market is open.
+//
+// Parameters:
+//   - market: The current state of the market. Required.
+//   - now: The current time. Required.
+//
+// Returns:
+//   - bool: Whether the bet is due.
+func (b ScheduledBet) Due(market *LiteMarket, now time.Time) bool {
+	open := !market.IsResolved && (market.CloseTime == nil || now.UnixMilli() < *market.CloseTime)
+	if !open {
+		return false
+	}
+
+	if b.At != nil && now.Before(*b.At) {
+		return false
+	}
+
+	if b.BeforeClose != nil {
+		if market.CloseTime == nil {
+			return false
+		}
+
+		if now.Before(time.UnixMilli(*market.CloseTime).Add(-*b.BeforeClose)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Schedule polls the market of a scheduled bet at the given interval and places the bet once it is due.
+//
+// Parameters:
+//   - interval: The time between polls of the market. Required.
+//   - stop: A channel that abandons the bet when closed. Required.
+//
+// Returns:
+//   - *Bet: The placed bet, or nil if stop was closed first.
+//   - error: An error object if the market resolves before the bet is due, or if placing the bet fails.
+func (s *BetService) Schedule(bet ScheduledBet, interval time.Duration, stop <-chan struct{}) (*Bet, error) {
+	if err := checkOneOf(bet.Outcome, "YES", "NO"); err != nil {
+		return nil, fmt.Errorf("Bet: Schedule(outcome): %w", err)
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		// Failed polls are retried on the next tick rather than abandoning the bet.
+		market, err := s.client.Market.Market(bet.ContractID)
+		if err == nil {
+			if market.IsResolved {
+				return nil, fmt.Errorf("Bet: Schedule: market resolved before the bet was due")
+			}
+
+			if bet.Due(&market.LiteMarket, s.client.Now()) {
+				placed, err := s.Create(bet.Amount, bet.ContractID, &bet.Outcome, bet.LimitProb, nil, nil)
+				if err != nil {
+					return nil, fmt.Errorf("Bet: Schedule: %w", err)
+				}
+
+				return placed, nil
+			}
+		}
+
+		select {
+		case <-stop:
+			return nil, nil
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,125 @@
+package manifold
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// SelfMatchPolicy determines what happens when a new order would match against the same account's resting limit orders.
+type SelfMatchPolicy string
+
+const (
+	SelfMatchSkip   SelfMatchPolicy = "SKIP"   // Refuse to place the new order
+	SelfMatchAdjust SelfMatchPolicy = "ADJUST" // Tighten the limit of the new order so it stops short of the resting orders
+	SelfMatchCancel SelfMatchPolicy = "CANCEL" // Cancel the crossing resting orders before placing the new order
+)
+
+// SelfMatches returns the resting limit orders that an order for the given outcome and limit would match against.
+// Buying YES fills resting NO orders at or below its limit, and buying NO fills resting YES orders at or above it.
+//
+// Parameters:
+//   - resting: The open limit orders of the account placing the order. Required.
+//   - outcome: The outcome the new order buys ("YES" or "NO"). Required.
+//   - limitProb: The limit of the new order. A market order is treated as having no limit. Optional.
+//
+// Returns:
+//   - []Bet: The resting orders that would be matched.
+func SelfMatches(resting []Bet, outcome string, limitProb *float64) []Bet {
+	limit := 1.0
+	if outcome == "NO" {
+		limit = 0
+	}
+	if limitProb != nil {
+		limit = *limitProb
+	}
+
+	var matches []Bet
+	for _, bet := range resting {
+		if bet.LimitProps == nil || bet.LimitProps.IsFilled || bet.LimitProps.IsCancelled || bet.Outcome == outcome {
+			continue
+		}
+
+		if (outcome == "YES" && bet.LimitProps.LimitProb <= limit) || (outcome == "NO" && bet.LimitProps.LimitProb >= limit) {
+			matches = append(matches, bet)
+		}
+	}
+
+	return matches
+}
+
+// CreateWithoutSelfMatch places a new bet after checking it against the authenticated user's resting limit orders on the
+// same contract, applying the given policy if the bet would match against any of them.
+//
+// Parameters:
+//   - amount: The amount of the bet. Required.
+//   - contractID: The ID of the contract on which the bet is being placed. Required.
+//   - outcome: The outcome of the bet ("YES" or "NO"). Required.
+//   - limitProb: Probability threshold for a limit order. Must be between 0 and 1. Optional.
+//   - expiresAt: Expiration time for a limit order. Only valid if limitProb is set. Optional.
+//   - policy: What to do if the bet would match against the user's own orders. Required.
+//
+// Returns:
+//   - *Bet: The created bet object.
+//   - error: An error wrapping ErrorSelfMatch if the bet is skipped, or an error object if a request or input validation fails.
+func (s *BetService) CreateWithoutSelfMatch(amount float64, contractID string, outcome string, limitProb *float64, expiresAt *time.Time, policy SelfMatchPolicy) (*Bet, error) {
+	if err := checkOneOf(outcome, "YES", "NO"); err != nil {
+		return nil, fmt.Errorf("Bet: CreateWithoutSelfMatch(outcome): %w", err)
+	}
+
+	if err := checkOneOf(policy, SelfMatchSkip, SelfMatchAdjust, SelfMatchCancel); err != nil {
+		return nil, fmt.Errorf("Bet: CreateWithoutSelfMatch(policy): %w", err)
+	}
+
+	me, err := s.client.User.Me()
+	if err != nil {
+		return nil, fmt.Errorf("Bet: CreateWithoutSelfMatch: %w", err)
+	}
+
+	kinds := "open-limit"
+	resting, err := s.Bets(&me.ID, nil, &contractID, nil, nil, nil, nil, nil, nil, &kinds, nil)
+	if err != nil {
+		return nil, fmt.Errorf("Bet: CreateWithoutSelfMatch: %w", err)
+	}
+
+	matches := SelfMatches(resting, outcome, limitProb)
+	if len(matches) > 0 {
+		switch policy {
+		case SelfMatchSkip:
+			return nil, fmt.Errorf("Bet: CreateWithoutSelfMatch: %w: would match %d resting orders", ErrorSelfMatch, len(matches))
+
+		case SelfMatchAdjust:
+			// Stop one percentage point short of the nearest resting order.
+			nearest := matches[0].LimitProps.LimitProb
+			for _, match := range matches[1:] {
+				if outcome == "YES" {
+					nearest = math.Min(nearest, match.LimitProps.LimitProb)
+				} else {
+					nearest = math.Max(nearest, match.LimitProps.LimitProb)
+				}
+			}
+
+			adjusted := nearest - 0.01
+			if outcome == "NO" {
+				adjusted = nearest + 0.01
+			}
+
+			adjusted = math.Round(adjusted*100) / 100
+
+			if adjusted <= 0 || adjusted >= 1 {
+				return nil, fmt.Errorf("Bet: CreateWithoutSelfMatch: %w: no limit avoids the resting orders", ErrorSelfMatch)
+			}
+
+			limitProb = &adjusted
+
+		case SelfMatchCancel:
+			for _, match := range matches {
+				if err := s.Cancel(match.ID); err != nil {
+					return nil, fmt.Errorf("Bet: CreateWithoutSelfMatch: %w", err)
+				}
+			}
+		}
+	}
+
+	return s.Create(amount, contractID, &outcome, limitProb, expiresAt, nil)
+}
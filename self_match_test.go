@@ -0,0 +1,52 @@
+package manifold
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSelfMatches(t *testing.T) {
+	order := func(id string, outcome string, limit float64) Bet {
+		return Bet{ID: id, Outcome: outcome, LimitProps: &LimitProps{LimitProb: limit}}
+	}
+	filled := order("filled", "NO", 0.4)
+	filled.LimitProps.IsFilled = true
+	cancelled := order("cancelled", "NO", 0.4)
+	cancelled.LimitProps.IsCancelled = true
+
+	resting := []Bet{
+		order("no40", "NO", 0.4),
+		order("no60", "NO", 0.6),
+		order("yes30", "YES", 0.3),
+		order("yes50", "YES", 0.5),
+		filled,
+		cancelled,
+		{ID: "market", Outcome: "NO"},
+	}
+
+	tests := []struct {
+		name      string
+		outcome   string
+		limitProb *float64
+		want      []string
+	}{
+		{"YES market order", "YES", nil, []string{"no40", "no60"}},
+		{"YES limit", "YES", ptr(0.5), []string{"no40"}},
+		{"YES limit at resting limit", "YES", ptr(0.4), []string{"no40"}},
+		{"YES limit below all", "YES", ptr(0.3), nil},
+		{"NO market order", "NO", nil, []string{"yes30", "yes50"}},
+		{"NO limit", "NO", ptr(0.4), []string{"yes50"}},
+		{"NO limit above all", "NO", ptr(0.6), nil},
+	}
+
+	for _, tt := range tests {
+		var got []string
+		for _, bet := range SelfMatches(resting, tt.outcome, tt.limitProb) {
+			got = append(got, bet.ID)
+		}
+
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: SelfMatches() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,106 @@
+package manifold
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// ActivityPoint is the trading activity of a market during a single interval of an activity series.
+type ActivityPoint struct {
+	Start         time.Time // Start of the interval
+	Volume        float64   // Traded volume, counting sales as volume too
+	Bets          int       // Number of bets placed
+	NewBettors    int       // Number of users who bet on the market for the first time
+	UniqueBettors int       // Total number of unique bettors by the end of the interval
+}
+
+// ActivitySeries computes the volume and unique bettor series of a market from its bet history, such as hourly or daily activity.
+// Bets placed before start are only used to know which bettors are already counted.
+//
+// Parameters:
+//   - bets: The bet history of the market, in any order. Required.
+//   - start: The start of the first interval. Required.
+//   - end: The end of the series. Must be after start. Required.
+//   - interval: The length of each interval. Must be greater than zero. Required.
+//
+// Returns:
+//   - []ActivityPoint: The activity of every interval, in order.
+//   - error: An error object if input validation fails.
+func ActivitySeries(bets []Bet, start time.Time, end time.Time, interval time.Duration) ([]ActivityPoint, error) {
+	if !end.After(start) {
+		return nil, fmt.Errorf("ActivitySeries: end must be after start")
+	}
+
+	if interval <= 0 {
+		return nil, fmt.Errorf("ActivitySeries(interval): invalid value: %v, must be greater than 0", interval)
+	}
+
+	sorted := make([]Bet, len(bets))
+	copy(sorted, bets)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].CreatedTime < sorted[j].CreatedTime
+	})
+
+	count := int((end.Sub(start) + interval - 1) / interval)
+	points := make([]ActivityPoint, count)
+	for i := range points {
+		points[i].Start = start.Add(time.Duration(i) * interval)
+	}
+
+	seen := make(map[string]bool)
+	startMs, endMs := start.UnixMilli(), end.UnixMilli()
+	for _, bet := range sorted {
+		if bet.CreatedTime >= endMs {
+			break
+		}
+
+		if bet.CreatedTime < startMs {
+			if !bet.IsRedemption {
+				seen[bet.UserID] = true
+			}
+			continue
+		}
+
+		point := &points[int(time.UnixMilli(bet.CreatedTime).Sub(start)/interval)]
+		point.Bets++
+		if bet.Amount < 0 {
+			point.Volume -= bet.Amount
+		} else {
+			point.Volume += bet.Amount
+		}
+
+		if !seen[bet.UserID] && !bet.IsRedemption {
+			seen[bet.UserID] = true
+			point.NewBettors++
+		}
+	}
+
+	unique := len(seen)
+	for i := len(points) - 1; i >= 0; i-- {
+		points[i].UniqueBettors = unique
+		unique -= points[i].NewBettors
+	}
+
+	return points, nil
+}
+
+// Series computes the activity series of a tracked market from its stored bet history (see ActivitySeries).
+//
+// Parameters:
+//   - contractID: The ID of the market. Required.
+//   - start: The start of the first interval. Required.
+//   - end: The end of the series. Must be after start. Required.
+//   - interval: The length of each interval. Must be greater than zero. Required.
+//
+// Returns:
+//   - []ActivityPoint: The activity of every interval, in order.
+//   - error: An error object if input validation fails.
+func (h *BetHistory) Series(contractID string, start time.Time, end time.Time, interval time.Duration) ([]ActivityPoint, error) {
+	points, err := ActivitySeries(h.Bets(contractID), start, end, interval)
+	if err != nil {
+		return nil, fmt.Errorf("BetHistory: %w", err)
+	}
+
+	return points, nil
+}
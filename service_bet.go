@@ -1,7 +1,6 @@
 package manifold
 
 import (
-	"encoding/json"
 	"fmt"
 	"net/url"
 	"time"
@@ -9,7 +8,9 @@ import (
 
 // BetService provides methods for interacting with bets, including retrieving bets, creating new bets, and canceling existing bets.
 type BetService struct {
-	client *Client
+	client     *Client
+	paper      *PaperBook
+	closeGuard *CloseGuard
 }
 
 // Bets retrieves a list of bets based on various filtering criteria.
@@ -89,21 +90,16 @@ func (s *BetService) Bets(userID *string, username *string, contractID *string,
 		params["order"] = *order
 	}
 
-	result, err := s.client.GET("/bets", params)
+	bets, err := GetJSON[[]Bet](s.client, "/bets", params)
 	if err != nil {
-		return nil, fmt.Errorf("Bet: Bets: %w: %w", ErrorGETFailed, err)
-	}
-
-	bets := make([]Bet, 0)
-	err = json.Unmarshal(result, &bets)
-	if err != nil {
-		return nil, fmt.Errorf("Bet: Bets: %w: %w", ErrorFailedToParseResponse, err)
+		return nil, fmt.Errorf("Bet: Bets: %w", err)
 	}
 
 	return bets, nil
 }
 
-// Create places a new bet on a contract.
+// Create places a new bet on a contract. In paper trading mode (see SetPaper), the bet is simulated and recorded
+// in the paper book instead.
 //
 // Parameters:
 //   - amount: The amount of the bet. Required.
@@ -143,13 +139,30 @@ func (s *BetService) Create(amount float64, contractID string, outcome *string,
 			return nil, fmt.Errorf("Bet: Create(expiresAt): only limit orders can have an expiresAt")
 		}
 
-		if time.Now().After(*expiresAt) {
+		if s.client.Now().After(*expiresAt) {
 			return nil, fmt.Errorf("Bet: Create(expiresAt): limit order cannot expire in the past")
 		}
 
 		body["expiresAt"] = fmt.Sprintf("%d", expiresAt.UnixMilli())
 	}
 
+	if s.closeGuard != nil {
+		if err := s.closeGuard.check(s.client, contractID, limitProb, expiresAt); err != nil {
+			return nil, fmt.Errorf("Bet: Create: %w", err)
+		}
+	}
+
+	// In paper trading mode, bets are simulated and recorded rather than placed.
+	paper := s.paper != nil && (dryRun == nil || !*dryRun)
+	if paper {
+		if err := s.paper.reserve(amount); err != nil {
+			return nil, fmt.Errorf("Bet: Create: %w", err)
+		}
+		defer s.paper.release(amount)
+
+		dryRun = ptr(true)
+	}
+
 	if dryRun != nil {
 		if *dryRun {
 			body["dryRun"] = "true"
@@ -158,21 +171,19 @@ func (s *BetService) Create(amount float64, contractID string, outcome *string,
 		}
 	}
 
-	result, err := s.client.POST("/bet", body)
+	bet, err := PostJSON[*Bet](s.client, "/bet", body)
 	if err != nil {
-		return nil, fmt.Errorf("Bet: Create: %w: %w", ErrorPOSTFailed, err)
+		return nil, fmt.Errorf("Bet: Create: %w", err)
 	}
 
-	bet := new(Bet)
-	err = json.Unmarshal(result, bet)
-	if err != nil {
-		return nil, fmt.Errorf("Bet: Create: %w: %w", ErrorFailedToParseResponse, err)
+	if paper {
+		s.paper.record(bet)
 	}
 
 	return bet, nil
 }
 
-// Cancel cancels an existing bet.
+// Cancel cancels an existing bet. In paper trading mode (see SetPaper), it cancels an order in the paper book instead.
 //
 // Parameters:
 //   - id: The ID of the bet to cancel. Required.
@@ -180,6 +191,13 @@ func (s *BetService) Create(amount float64, contractID string, outcome *string,
 // Returns:
 //   - error: An error object if the request fails or if the response cannot be parsed.
 func (s *BetService) Cancel(id string) error {
+	if s.paper != nil {
+		if err := s.paper.cancel(id); err != nil {
+			return fmt.Errorf("Bet: Cancel: %w", err)
+		}
+		return nil
+	}
+
 	_, err := s.client.POST(
 		fmt.Sprintf("/bet/cancel/%s", url.PathEscape(id)), nil,
 	)
@@ -189,3 +207,148 @@ func (s *BetService) Cancel(id string) error {
 
 	return nil
 }
+
+// BuyYes places a market order buying YES shares on a contract.
+//
+// Parameters:
+//   - contractID: The ID of the contract on which the bet is being placed. Required.
+//   - amount: The amount of the bet. Required.
+//
+// Returns:
+//   - *Bet: The created bet object.
+//   - error: An error object if the request fails or if the response cannot be parsed.
+func (s *BetService) BuyYes(contractID string, amount float64) (*Bet, error) {
+	return s.Create(amount, contractID, ptr("YES"), nil, nil, nil)
+}
+
+// BuyNo places a market order buying NO shares on a contract.
+//
+// Parameters:
+//   - contractID: The ID of the contract on which the bet is being placed. Required.
+//   - amount: The amount of the bet. Required.
+//
+// Returns:
+//   - *Bet: The created bet object.
+//   - error: An error object if the request fails or if the response cannot be parsed.
+func (s *BetService) BuyNo(contractID string, amount float64) (*Bet, error) {
+	return s.Create(amount, contractID, ptr("NO"), nil, nil, nil)
+}
+
+// LimitYes places a limit order buying YES shares on a contract.
+//
+// Parameters:
+//   - contractID: The ID of the contract on which the bet is being placed. Required.
+//   - amount: The amount of the bet. Required.
+//   - limitProb: Probability threshold for the order. Must be between 0 and 1. Required.
+//   - expiresAt: Expiration time for the order. Must be in the future. Optional.
+//
+// Returns:
+//   - *Bet: The created bet object.
+//   - error: An error object if the request fails, input validation fails, or the response cannot be parsed.
+func (s *BetService) LimitYes(contractID string, amount float64, limitProb float64, expiresAt *time.Time) (*Bet, error) {
+	return s.Create(amount, contractID, ptr("YES"), &limitProb, expiresAt, nil)
+}
+
+// LimitNo places a limit order buying NO shares on a contract.
+//
+// Parameters:
+//   - contractID: The ID of the contract on which the bet is being placed. Required.
+//   - amount: The amount of the bet. Required.
+//   - limitProb: Probability threshold for the order. Must be between 0 and 1. Required.
+//   - expiresAt: Expiration time for the order. Must be in the future. Optional.
+//
+// Returns:
+//   - *Bet: The created bet object.
+//   - error: An error object if the request fails, input validation fails, or the response cannot be parsed.
+func (s *BetService) LimitNo(contractID string, amount float64, limitProb float64, expiresAt *time.Time) (*Bet, error) {
+	return s.Create(amount, contractID, ptr("NO"), &limitProb, expiresAt, nil)
+}
+
+// allBets retrieves every bet matching the given filters, following the before cursor until the history is exhausted.
+// The bets are returned in descending order of placement time.
+func (s *BetService) allBets(userID *string, contractID *string, afterTime *time.Time) ([]Bet, error) {
+	var (
+		bets   []Bet
+		before *string
+		limit  = 1000
+	)
+
+	for {
+		page, err := s.Bets(userID, nil, contractID, nil, &limit, before, nil, nil, afterTime, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		bets = append(bets, page...)
+		if len(page) < limit {
+			return bets, nil
+		}
+
+		before = &page[len(page)-1].ID
+	}
+}
+
+// openOrders retrieves every open limit order of a user, following the before cursor until they are exhausted.
+func (s *BetService) openOrders(userID string) ([]Bet, error) {
+	var (
+		orders []Bet
+		before *string
+		limit  = 1000
+		kinds  = "open-limit"
+	)
+
+	for {
+		page, err := s.Bets(&userID, nil, nil, nil, &limit, before, nil, nil, nil, &kinds, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		orders = append(orders, page...)
+		if len(page) < limit {
+			return orders, nil
+		}
+
+		before = &page[len(page)-1].ID
+	}
+}
+
+// findBet retrieves a single bet placed by a user on a contract, or nil if it cannot be found. The user's bets are
+// paged newest first until the bet is found, so only bets placed since it are fetched. If known, since is a timestamp
+// the bet was placed at or after, which bounds the search when the bet does not exist, and is 0 otherwise. In paper
+// trading mode, orders recorded in the paper book are returned from it.
+func (s *BetService) findBet(userID string, contractID string, id string, since int64) (*Bet, error) {
+	if s.paper != nil {
+		if bet, ok := s.paper.lookup(id); ok {
+			return bet, nil
+		}
+	}
+
+	var (
+		before    *string
+		afterTime *time.Time
+		limit     = 1000
+	)
+	if since > 0 {
+		after := time.UnixMilli(since - 1)
+		afterTime = &after
+	}
+
+	for {
+		page, err := s.Bets(&userID, nil, &contractID, nil, &limit, before, nil, nil, afterTime, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range page {
+			if page[i].ID == id {
+				return &page[i], nil
+			}
+		}
+
+		if len(page) < limit {
+			return nil, nil
+		}
+
+		before = &page[len(page)-1].ID
+	}
+}
@@ -1,7 +1,6 @@
 package manifold
 
 import (
-	"encoding/json"
 	"fmt"
 )
 
@@ -9,6 +8,15 @@ import (
 // including retrieving, posting text, HTML, and Markdown comments.
 type CommentService struct {
 	client *Client
+	guard  *CommentGuard
+}
+
+// SetGuard sets the guard that rate limits and deduplicates comments posted through the service.
+//
+// Parameters:
+//   - guard: The guard to apply to every posted comment. If nil, comments are no longer guarded.
+func (s *CommentService) SetGuard(guard *CommentGuard) {
+	s.guard = guard
 }
 
 // Comments retrieves a list of comments for a specific contract.
@@ -54,15 +62,9 @@ func (s *CommentService) Comments(contractID *string, contractSlug *string, limi
 		params["userId"] = *userID
 	}
 
-	result, err := s.client.GET("/comments", params)
-	if err != nil {
-		return nil, fmt.Errorf("Comment: Comments: %w: %w", ErrorGETFailed, err)
-	}
-
-	comments := make([]Comment, 0)
-	err = json.Unmarshal(result, &comments)
+	comments, err := GetJSON[[]Comment](s.client, "/comments", params)
 	if err != nil {
-		return nil, fmt.Errorf("Comment: Comments: %w: %w", ErrorFailedToParseResponse, err)
+		return nil, fmt.Errorf("Comment: Comments: %w", err)
 	}
 
 	return comments, nil
@@ -77,14 +79,39 @@ func (s *CommentService) Comments(contractID *string, contractSlug *string, limi
 // Returns:
 //   - error: An error object if the request fails or if the response cannot be parsed.
 func (s *CommentService) Comment(id string, content string) error {
+	content, err := s.client.filterContent(content)
+	if err != nil {
+		return fmt.Errorf("Comment: Comment: %w", err)
+	}
+
 	body := map[string]string{
 		"contractId": id,
 		"content":    content,
 	}
 
-	_, err := s.client.POST("/comment", body)
-	if err != nil {
-		return fmt.Errorf("Comment: Comment: %w: %w", ErrorPOSTFailed, err)
+	if err := s.post(id, content, body); err != nil {
+		return fmt.Errorf("Comment: Comment: %w", err)
+	}
+
+	return nil
+}
+
+// post posts a comment after checking it against the guard, if set. A comment that fails to post is forgotten by the
+// guard, so that it can be retried.
+func (s *CommentService) post(id string, content string, body map[string]string) error {
+	var record commentRecord
+	if s.guard != nil {
+		var err error
+		if record, err = s.guard.allow(id, content); err != nil {
+			return err
+		}
+	}
+
+	if _, err := s.client.POST("/comment", body); err != nil {
+		if s.guard != nil {
+			s.guard.forget(record)
+		}
+		return fmt.Errorf("%w: %w", ErrorPOSTFailed, err)
 	}
 
 	return nil
@@ -99,14 +126,18 @@ func (s *CommentService) Comment(id string, content string) error {
 // Returns:
 //   - error: An error object if the request fails or if the response cannot be parsed.
 func (s *CommentService) CommentHTML(id string, content string) error {
+	content, err := s.client.filterContent(content)
+	if err != nil {
+		return fmt.Errorf("Comment: CommentHTML: %w", err)
+	}
+
 	body := map[string]string{
 		"contractId": id,
 		"html":       content,
 	}
 
-	_, err := s.client.POST("/comment", body)
-	if err != nil {
-		return fmt.Errorf("Comment: CommentHTML: %w: %w", ErrorPOSTFailed, err)
+	if err := s.post(id, content, body); err != nil {
+		return fmt.Errorf("Comment: CommentHTML: %w", err)
 	}
 
 	return nil
@@ -121,14 +152,18 @@ func (s *CommentService) CommentHTML(id string, content string) error {
 // Returns:
 //   - error: An error object if the request fails or if the response cannot be parsed.
 func (s *CommentService) CommentMarkdown(id string, content string) error {
+	content, err := s.client.filterContent(content)
+	if err != nil {
+		return fmt.Errorf("Comment: CommentMarkdown: %w", err)
+	}
+
 	body := map[string]string{
 		"contractId": id,
 		"markdown":   content,
 	}
 
-	_, err := s.client.POST("/comment", body)
-	if err != nil {
-		return fmt.Errorf("Comment: CommentMarkdown: %w: %w", ErrorPOSTFailed, err)
+	if err := s.post(id, content, body); err != nil {
+		return fmt.Errorf("Comment: CommentMarkdown: %w", err)
 	}
 
 	return nil
@@ -1,7 +1,6 @@
 package manifold
 
 import (
-	"encoding/json"
 	"fmt"
 	"net/url"
 	"time"
@@ -33,17 +32,9 @@ func (s *GroupService) Groups(beforeTime *time.Time, availableToUserID *string)
 		params["availableToUserID"] = *availableToUserID
 	}
 
-	result, err := s.client.GET(
-		"/groups", params,
-	)
+	groups, err := GetJSON[[]Group](s.client, "/groups", params)
 	if err != nil {
-		return nil, fmt.Errorf("Group: Groups: %w: %w", ErrorGETFailed, err)
-	}
-
-	groups := make([]Group, 0)
-	err = json.Unmarshal(result, &groups)
-	if err != nil {
-		return nil, fmt.Errorf("Group: Groups: %w: %w", ErrorFailedToParseResponse, err)
+		return nil, fmt.Errorf("Group: Groups: %w", err)
 	}
 
 	return groups, nil
@@ -58,17 +49,9 @@ func (s *GroupService) Groups(beforeTime *time.Time, availableToUserID *string)
 //   - *Group: A pointer to the retrieved group object.
 //   - error: An error object if the request fails or if the response cannot be parsed.
 func (s *GroupService) Group(slug string) (*Group, error) {
-	result, err := s.client.GET(
-		fmt.Sprintf("/group/%s", url.PathEscape(slug)), nil,
-	)
+	group, err := GetJSON[*Group](s.client, fmt.Sprintf("/group/%s", url.PathEscape(slug)), nil)
 	if err != nil {
-		return nil, fmt.Errorf("Group: Group: %w: %w", ErrorGETFailed, err)
-	}
-
-	group := new(Group)
-	err = json.Unmarshal(result, group)
-	if err != nil {
-		return nil, fmt.Errorf("Group: Group: %w: %w", ErrorFailedToParseResponse, err)
+		return nil, fmt.Errorf("Group: Group: %w", err)
 	}
 
 	return group, nil
@@ -83,17 +66,9 @@ func (s *GroupService) Group(slug string) (*Group, error) {
 //   - *Group: A pointer to the retrieved group object.
 //   - error: An error object if the request fails or if the response cannot be parsed.
 func (s *GroupService) ID(id string) (*Group, error) {
-	result, err := s.client.GET(
-		fmt.Sprintf("/group/by-id/%s", url.PathEscape(id)), nil,
-	)
-	if err != nil {
-		return nil, fmt.Errorf("Group: ID: %w: %w", ErrorGETFailed, err)
-	}
-
-	group := new(Group)
-	err = json.Unmarshal(result, group)
+	group, err := GetJSON[*Group](s.client, fmt.Sprintf("/group/by-id/%s", url.PathEscape(id)), nil)
 	if err != nil {
-		return nil, fmt.Errorf("Group: ID: %w: %w", ErrorFailedToParseResponse, err)
+		return nil, fmt.Errorf("Group: ID: %w", err)
 	}
 
 	return group, nil
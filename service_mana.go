@@ -1,7 +1,6 @@
 package manifold
 
 import (
-	"encoding/json"
 	"fmt"
 	"time"
 )
@@ -49,15 +48,9 @@ func (s *ManaService) Managrams(toID *string, fromID *string, limit *int, before
 		params["after"] = fmt.Sprintf("%d", after.UnixMilli())
 	}
 
-	result, err := s.client.GET("/managrams", params)
+	managrams, err := GetJSON[[]Txn](s.client, "/managrams", params)
 	if err != nil {
-		return nil, fmt.Errorf("Mana: Managrams: %w: %w", ErrorGETFailed, err)
-	}
-
-	managrams := make([]Txn, 0)
-	err = json.Unmarshal(result, &managrams)
-	if err != nil {
-		return nil, fmt.Errorf("Mana: Managrams: %w: %w", ErrorFailedToParseResponse, err)
+		return nil, fmt.Errorf("Mana: Managrams: %w", err)
 	}
 
 	return managrams, nil
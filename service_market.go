@@ -1,8 +1,8 @@
 package manifold
 
 import (
-	"encoding/json"
 	"fmt"
+	"math"
 	"net/url"
 	"time"
 )
@@ -78,24 +78,16 @@ func (s *MarketService) Markets(limit *int, sort *string, order *string, before
 	}
 
 	if userID != nil {
-		params["userID"] = *userID
+		params["userId"] = *userID
 	}
 
 	if groupID != nil {
-		params["groupID"] = *groupID
+		params["groupId"] = *groupID
 	}
 
-	result, err := s.client.GET(
-		"/markets", params,
-	)
+	markets, err := GetJSON[[]LiteMarket](s.client, "/markets", params)
 	if err != nil {
-		return nil, fmt.Errorf("Market: Markets: %w: %w", ErrorGETFailed, err)
-	}
-
-	markets := make([]LiteMarket, 0)
-	err = json.Unmarshal(result, &markets)
-	if err != nil {
-		return nil, fmt.Errorf("Market: Markets: %w: %w", ErrorFailedToParseResponse, err)
+		return nil, fmt.Errorf("Market: Markets: %w", err)
 	}
 
 	return markets, nil
@@ -110,17 +102,9 @@ func (s *MarketService) Markets(limit *int, sort *string, order *string, before
 //   - *FullMarket: A pointer to the retrieved market object.
 //   - error: An error object if the request fails or if the response cannot be parsed.
 func (s *MarketService) Market(id string) (*FullMarket, error) {
-	result, err := s.client.GET(
-		fmt.Sprintf("/market/%s", url.PathEscape(id)), nil,
-	)
+	market, err := GetJSON[*FullMarket](s.client, fmt.Sprintf("/market/%s", url.PathEscape(id)), nil)
 	if err != nil {
-		return nil, fmt.Errorf("Market: Market: %w: %w", ErrorGETFailed, err)
-	}
-
-	market := new(FullMarket)
-	err = json.Unmarshal(result, market)
-	if err != nil {
-		return nil, fmt.Errorf("Market: Market: %w: %w", ErrorFailedToParseResponse, err)
+		return nil, fmt.Errorf("Market: Market: %w", err)
 	}
 
 	return market, nil
@@ -135,17 +119,9 @@ func (s *MarketService) Market(id string) (*FullMarket, error) {
 //   - []ContractMetric: A slice of contract metrics representing the positions.
 //   - error: An error object if the request fails or if the response cannot be parsed.
 func (s *MarketService) Positions(id string) ([]ContractMetric, error) {
-	result, err := s.client.GET(
-		fmt.Sprintf("/market/%s/positions", url.PathEscape(id)), nil,
-	)
-	if err != nil {
-		return nil, fmt.Errorf("Market: Positions: %w: %w", ErrorGETFailed, err)
-	}
-
-	positions := make([]ContractMetric, 0)
-	err = json.Unmarshal(result, &positions)
+	positions, err := GetJSON[[]ContractMetric](s.client, fmt.Sprintf("/market/%s/positions", url.PathEscape(id)), nil)
 	if err != nil {
-		return nil, fmt.Errorf("Market: Positions: %w, %w", ErrorFailedToParseResponse, err)
+		return nil, fmt.Errorf("Market: Positions: %w", err)
 	}
 
 	return positions, nil
@@ -160,17 +136,9 @@ func (s *MarketService) Positions(id string) ([]ContractMetric, error) {
 //   - *FullMarket: A pointer to the retrieved market object.
 //   - error: An error object if the request fails or if the response cannot be parsed.
 func (s *MarketService) Slug(slug string) (*FullMarket, error) {
-	result, err := s.client.GET(
-		fmt.Sprintf("/slug/%s", url.PathEscape(slug)), nil,
-	)
-	if err != nil {
-		return nil, fmt.Errorf("Market: Slug: %w: %w", ErrorGETFailed, err)
-	}
-
-	market := new(FullMarket)
-	err = json.Unmarshal(result, market)
+	market, err := GetJSON[*FullMarket](s.client, fmt.Sprintf("/slug/%s", url.PathEscape(slug)), nil)
 	if err != nil {
-		return nil, fmt.Errorf("Market: Slug: %w: %w", ErrorFailedToParseResponse, err)
+		return nil, fmt.Errorf("Market: Slug: %w", err)
 	}
 
 	return market, nil
@@ -193,7 +161,7 @@ func (s *MarketService) Slug(slug string) (*FullMarket, error) {
 //   - error: An error object if the request fails or if input validation fails.
 func (s *MarketService) Search(term string, sort *string, filter *string, contractType *string, topicSlug *string, creatorID *string, limit *int, offset *int) ([]LiteMarket, error) {
 	params := make(map[string]string, 8)
-	params[term] = term
+	params["term"] = term
 
 	if sort != nil {
 		if err := checkOneOf(*sort, allowedMarketSearchSort...); err != nil {
@@ -243,17 +211,9 @@ func (s *MarketService) Search(term string, sort *string, filter *string, contra
 		params["offset"] = fmt.Sprintf("%d", *offset)
 	}
 
-	result, err := s.client.GET(
-		"/search-markets", params,
-	)
-	if err != nil {
-		return nil, fmt.Errorf("Market: Search: %w: %w", ErrorGETFailed, err)
-	}
-
-	markets := make([]LiteMarket, 0)
-	err = json.Unmarshal(result, &markets)
+	markets, err := GetJSON[[]LiteMarket](s.client, "/search-markets", params)
 	if err != nil {
-		return nil, fmt.Errorf("Market: Search: %w: %w", ErrorFailedToParseResponse, err)
+		return nil, fmt.Errorf("Market: Search: %w", err)
 	}
 
 	return markets, nil
@@ -261,13 +221,29 @@ func (s *MarketService) Search(term string, sort *string, filter *string, contra
 
 // Helper method to create a market.
 func (s *MarketService) createMarket(params map[string]interface{}) (*LiteMarket, error) {
-	result, err := s.client.POST("/market", params)
-	if err != nil {
-		return nil, fmt.Errorf("Market: createMarket: %w", err)
+	for _, key := range []string{"question", "description"} {
+		if text, ok := params[key].(string); ok {
+			filtered, err := s.client.filterContent(text)
+			if err != nil {
+				return nil, fmt.Errorf("Market: createMarket(%s): %w", key, err)
+			}
+			params[key] = filtered
+		}
+	}
+
+	if answers, ok := params["answers"].([]string); ok {
+		filtered := make([]string, len(answers))
+		for i, answer := range answers {
+			text, err := s.client.filterContent(answer)
+			if err != nil {
+				return nil, fmt.Errorf("Market: createMarket(answers): %w", err)
+			}
+			filtered[i] = text
+		}
+		params["answers"] = filtered
 	}
 
-	market := new(LiteMarket)
-	err = json.Unmarshal(result, market)
+	market, err := PostJSON[*LiteMarket](s.client, "/market", params)
 	if err != nil {
 		return nil, fmt.Errorf("Market: createMarket: %w", err)
 	}
@@ -304,7 +280,7 @@ func (s *MarketService) CreateBinary(question string, initialProb int, descripti
 		params["description"] = *description
 	}
 	if closeTime != nil {
-		if time.Now().After(*closeTime) {
+		if s.client.Now().After(*closeTime) {
 			return nil, fmt.Errorf("Market: CreateBinary: closeTime cannot be in the past")
 		}
 		params["closeTime"] = closeTime.UnixMilli()
@@ -357,7 +333,7 @@ func (s *MarketService) CreatePseudoNumeric(question string, min, max, initialVa
 		params["description"] = *description
 	}
 	if closeTime != nil {
-		if time.Now().After(*closeTime) {
+		if s.client.Now().After(*closeTime) {
 			return nil, fmt.Errorf("Market: CreatePseudoNumeric: closeTime cannot be in the past")
 		}
 		params["closeTime"] = closeTime.UnixMilli()
@@ -403,7 +379,7 @@ func (s *MarketService) CreatePoll(question string, answers []string, descriptio
 		params["description"] = *description
 	}
 	if closeTime != nil {
-		if time.Now().After(*closeTime) {
+		if s.client.Now().After(*closeTime) {
 			return nil, fmt.Errorf("Market: CreatePoll: closeTime cannot be in the past")
 		}
 		params["closeTime"] = closeTime.UnixMilli()
@@ -446,7 +422,7 @@ func (s *MarketService) CreateBountiedQuestion(question string, totalBounty int,
 		params["description"] = *description
 	}
 	if closeTime != nil {
-		if time.Now().After(*closeTime) {
+		if s.client.Now().After(*closeTime) {
 			return nil, fmt.Errorf("Market: CreateBountiedQuestion: closeTime cannot be in the past")
 		}
 		params["closeTime"] = closeTime.UnixMilli()
@@ -502,17 +478,9 @@ func (s *MarketService) AddLiquidity(id string, amount float64) (*Txn, error) {
 		"amount": fmt.Sprintf("%f", amount),
 	}
 
-	response, err := s.client.POST(
-		fmt.Sprintf("/market/%s/add-liquidity", url.PathEscape(id)), body,
-	)
-	if err != nil {
-		return nil, fmt.Errorf("Market: AddLiquidity: %w: %w", ErrorPOSTFailed, err)
-	}
-
-	txn := new(Txn)
-	err = json.Unmarshal(response, txn)
+	txn, err := PostJSON[*Txn](s.client, fmt.Sprintf("/market/%s/add-liquidity", url.PathEscape(id)), body)
 	if err != nil {
-		return nil, fmt.Errorf("Market: AddLiquidity: %w: %w", ErrorFailedToParseResponse, err)
+		return nil, fmt.Errorf("Market: AddLiquidity: %w", err)
 	}
 
 	return txn, nil
@@ -536,17 +504,9 @@ func (s *MarketService) AddBounty(id string, amount float64) (*Txn, error) {
 		"amount": fmt.Sprintf("%f", amount),
 	}
 
-	response, err := s.client.POST(
-		fmt.Sprintf("/market/%s/add-bounty", url.PathEscape(id)), body,
-	)
+	txn, err := PostJSON[*Txn](s.client, fmt.Sprintf("/market/%s/add-bounty", url.PathEscape(id)), body)
 	if err != nil {
-		return nil, fmt.Errorf("Market: AddBounty: %w: %w", ErrorPOSTFailed, err)
-	}
-
-	txn := new(Txn)
-	err = json.Unmarshal(response, txn)
-	if err != nil {
-		return nil, fmt.Errorf("Market: AddBounty: %w: %w", ErrorFailedToParseResponse, err)
+		return nil, fmt.Errorf("Market: AddBounty: %w", err)
 	}
 
 	return txn, nil
@@ -572,17 +532,9 @@ func (s *MarketService) AwardBounty(id string, amount float64, commentID string)
 		"commentId": commentID,
 	}
 
-	response, err := s.client.POST(
-		fmt.Sprintf("/market/%s/award-bounty", url.PathEscape(id)), body,
-	)
-	if err != nil {
-		return nil, fmt.Errorf("Market: AwardBounty: %w: %w", ErrorPOSTFailed, err)
-	}
-
-	txn := new(Txn)
-	err = json.Unmarshal(response, txn)
+	txn, err := PostJSON[*Txn](s.client, fmt.Sprintf("/market/%s/award-bounty", url.PathEscape(id)), body)
 	if err != nil {
-		return nil, fmt.Errorf("Market: AwardBounty: %w: %w", ErrorFailedToParseResponse, err)
+		return nil, fmt.Errorf("Market: AwardBounty: %w", err)
 	}
 
 	return txn, nil
@@ -600,7 +552,7 @@ func (s *MarketService) Close(id string, closeTime *time.Time) error {
 	body := map[string]string{}
 
 	if closeTime != nil {
-		if time.Now().After(*closeTime) {
+		if s.client.Now().After(*closeTime) {
 			return fmt.Errorf("Market: Close(closeTime): cannot close a market in the past")
 		}
 
@@ -651,13 +603,7 @@ func (s *MarketService) Group(id string, groupID string, remove *bool) error {
 
 // Helper function to resolve a market.
 func (s *MarketService) resolveMarket(id string, params map[string]interface{}) (*LiteMarket, error) {
-	result, err := s.client.POST(fmt.Sprintf("/market/%s/resolve", url.PathEscape(id)), params)
-	if err != nil {
-		return nil, fmt.Errorf("Market: resolveMarket: %w", err)
-	}
-
-	market := new(LiteMarket)
-	err = json.Unmarshal(result, market)
+	market, err := PostJSON[*LiteMarket](s.client, fmt.Sprintf("/market/%s/resolve", url.PathEscape(id)), params)
 	if err != nil {
 		return nil, fmt.Errorf("Market: resolveMarket: %w", err)
 	}
@@ -699,6 +645,76 @@ func (s *MarketService) ResolveBinary(id string, outcome string, probabilityInt
 	return s.resolveMarket(id, params)
 }
 
+// CheckResolution checks a binary resolution against the current probability of the market.
+// Resolving YES is refused when the probability is below minYesProb, and resolving NO is refused
+// when the probability is above maxNoProb. Other outcomes are always accepted.
+//
+// Parameters:
+//   - id: The ID of the market to check. Required.
+//   - outcome: The outcome the market is about to be resolved to ("YES", "NO", "MKT", "CANCEL"). Required.
+//   - minYesProb: The lowest probability (between 0 and 1) at which a YES resolution is accepted. Required.
+//   - maxNoProb: The highest probability (between 0 and 1) at which a NO resolution is accepted. Required.
+//
+// Returns:
+//   - error: An error wrapping ErrorResolutionCheckFailed if the check fails, or an error object if the request fails.
+func (s *MarketService) CheckResolution(id string, outcome string, minYesProb float64, maxNoProb float64) error {
+	if err := checkInRange(minYesProb, 0, 1); err != nil {
+		return fmt.Errorf("Market: CheckResolution(minYesProb): %w", err)
+	}
+
+	if err := checkInRange(maxNoProb, 0, 1); err != nil {
+		return fmt.Errorf("Market: CheckResolution(maxNoProb): %w", err)
+	}
+
+	if outcome != "YES" && outcome != "NO" {
+		return nil
+	}
+
+	market, err := s.Market(id)
+	if err != nil {
+		return fmt.Errorf("Market: CheckResolution: %w", err)
+	}
+
+	if market.Probability == nil {
+		return fmt.Errorf("Market: CheckResolution: %w: market has no probability", ErrorResolutionCheckFailed)
+	}
+
+	prob := *market.Probability
+	if outcome == "YES" && prob < minYesProb {
+		return fmt.Errorf("Market: CheckResolution: %w: cannot resolve YES at probability %.3f, must be at least %.3f", ErrorResolutionCheckFailed, prob, minYesProb)
+	}
+
+	if outcome == "NO" && prob > maxNoProb {
+		return fmt.Errorf("Market: CheckResolution: %w: cannot resolve NO at probability %.3f, must be at most %.3f", ErrorResolutionCheckFailed, prob, maxNoProb)
+	}
+
+	return nil
+}
+
+// ResolveBinaryChecked resolves a binary market, first refusing resolutions that contradict the
+// current market probability (see CheckResolution) unless force is set.
+//
+// Parameters:
+//   - id: The ID of the market to resolve. Required.
+//   - outcome: The outcome of the market ("YES", "NO", "MKT", "CANCEL"). Required.
+//   - probabilityInt: The probability integer (0-100) if the outcome is "MKT". Optional.
+//   - minYesProb: The lowest probability (between 0 and 1) at which a YES resolution is accepted. Required.
+//   - maxNoProb: The highest probability (between 0 and 1) at which a NO resolution is accepted. Required.
+//   - force: If true, skips the probability check entirely. Required.
+//
+// Returns:
+//   - *LiteMarket: A pointer to the resolved market object.
+//   - error: An error object if the check fails, the request fails, or input validation fails.
+func (s *MarketService) ResolveBinaryChecked(id string, outcome string, probabilityInt *int, minYesProb float64, maxNoProb float64, force bool) (*LiteMarket, error) {
+	if !force {
+		if err := s.CheckResolution(id, outcome, minYesProb, maxNoProb); err != nil {
+			return nil, fmt.Errorf("Market: ResolveBinaryChecked: %w", err)
+		}
+	}
+
+	return s.ResolveBinary(id, outcome, probabilityInt)
+}
+
 // ResolveFreeResponse resolves a free response or multiple choice market.
 //
 // Parameters:
@@ -736,6 +752,99 @@ func (s *MarketService) ResolveFreeResponse(id string, outcome string, resolutio
 	return s.resolveMarket(id, params)
 }
 
+// CheckFreeResponseResolution checks a free response or multiple choice resolution against the current answer
+// probabilities of the market. Every answer the resolution pays out to, either the answer resolved to or an answer
+// with a positive percentage in resolutions, must have a probability of at least minProb. Cancellations are always
+// accepted.
+//
+// Parameters:
+//   - id: The ID of the market to check. Required.
+//   - outcome: The outcome the market is about to be resolved to ("MKT", "CANCEL", or an answer ID). Required.
+//   - resolutions: The resolutions with percentages for each answer if the outcome is "MKT". Optional.
+//   - minProb: The lowest probability (between 0 and 1) of an answer that is paid out. Required.
+//
+// Returns:
+//   - error: An error wrapping ErrorResolutionCheckFailed if the check fails, or an error object if the request fails.
+func (s *MarketService) CheckFreeResponseResolution(id string, outcome string, resolutions []Resolution, minProb float64) error {
+	if err := checkInRange(minProb, 0, 1); err != nil {
+		return fmt.Errorf("Market: CheckFreeResponseResolution(minProb): %w", err)
+	}
+
+	if outcome == "CANCEL" {
+		return nil
+	}
+
+	market, err := s.Market(id)
+	if err != nil {
+		return fmt.Errorf("Market: CheckFreeResponseResolution: %w", err)
+	}
+
+	if market.Answers == nil {
+		return fmt.Errorf("Market: CheckFreeResponseResolution: %w: market has no answers", ErrorResolutionCheckFailed)
+	}
+
+	check := func(answer *ApiAnswer, label string) error {
+		if answer == nil {
+			return fmt.Errorf("Market: CheckFreeResponseResolution: %w: answer %s not found", ErrorResolutionCheckFailed, label)
+		}
+		if answer.Prob < minProb {
+			return fmt.Errorf("Market: CheckFreeResponseResolution: %w: cannot pay out answer %s at probability %.3f, must be at least %.3f", ErrorResolutionCheckFailed, label, answer.Prob, minProb)
+		}
+
+		return nil
+	}
+
+	find := func(match func(ApiAnswer) bool) *ApiAnswer {
+		for i := range *market.Answers {
+			if match((*market.Answers)[i]) {
+				return &(*market.Answers)[i]
+			}
+		}
+
+		return nil
+	}
+
+	if outcome != "MKT" {
+		return check(find(func(a ApiAnswer) bool { return a.ID == outcome }), outcome)
+	}
+
+	for _, resolution := range resolutions {
+		if resolution.Pct <= 0 {
+			continue
+		}
+
+		answer := find(func(a ApiAnswer) bool { return a.Index == resolution.Answer })
+		if err := check(answer, fmt.Sprint(resolution.Answer)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ResolveFreeResponseChecked resolves a free response or multiple choice market, first refusing resolutions that pay
+// out unlikely answers (see CheckFreeResponseResolution) unless force is set.
+//
+// Parameters:
+//   - id: The ID of the market to resolve. Required.
+//   - outcome: The outcome of the market ("MKT", "CANCEL"). Required.
+//   - resolutions: A slice of resolutions with percentages for each outcome if outcome is "MKT". Optional.
+//   - minProb: The lowest probability (between 0 and 1) of an answer that is paid out. Required.
+//   - force: If true, skips the probability check entirely. Required.
+//
+// Returns:
+//   - *LiteMarket: A pointer to the resolved market object.
+//   - error: An error object if the check fails, the request fails, or input validation fails.
+func (s *MarketService) ResolveFreeResponseChecked(id string, outcome string, resolutions []Resolution, minProb float64, force bool) (*LiteMarket, error) {
+	if !force {
+		if err := s.CheckFreeResponseResolution(id, outcome, resolutions, minProb); err != nil {
+			return nil, fmt.Errorf("Market: ResolveFreeResponseChecked: %w", err)
+		}
+	}
+
+	return s.ResolveFreeResponse(id, outcome, resolutions)
+}
+
 // ResolveNumeric resolves a numeric market.
 //
 // Parameters:
@@ -774,6 +883,71 @@ func (s *MarketService) ResolveNumeric(id string, outcome string, value *float64
 	return s.resolveMarket(id, params)
 }
 
+// CheckPseudoNumericResolution checks a pseudo-numeric resolution against the current value of the market. A value
+// further from the current value than maxDistance, as a fraction of the range of the market, is refused.
+//
+// Parameters:
+//   - id: The ID of the market to check. Required.
+//   - value: The value the market is about to be resolved to. Required.
+//   - maxDistance: The largest accepted distance (between 0 and 1) from the current value, as a fraction of the range. Required.
+//
+// Returns:
+//   - error: An error wrapping ErrorResolutionCheckFailed if the check fails, or an error object if the request fails.
+func (s *MarketService) CheckPseudoNumericResolution(id string, value float64, maxDistance float64) error {
+	if err := checkInRange(maxDistance, 0, 1); err != nil {
+		return fmt.Errorf("Market: CheckPseudoNumericResolution(maxDistance): %w", err)
+	}
+
+	market, err := s.Market(id)
+	if err != nil {
+		return fmt.Errorf("Market: CheckPseudoNumericResolution: %w", err)
+	}
+
+	if market.Value == nil || market.Min == nil || market.Max == nil || *market.Max <= *market.Min {
+		return fmt.Errorf("Market: CheckPseudoNumericResolution: %w: market has no value range", ErrorResolutionCheckFailed)
+	}
+
+	distance := math.Abs(value-*market.Value) / (*market.Max - *market.Min)
+	if distance > maxDistance {
+		return fmt.Errorf("Market: CheckPseudoNumericResolution: %w: cannot resolve to %g at value %g, must be within %.3f of the range", ErrorResolutionCheckFailed, value, *market.Value, maxDistance)
+	}
+
+	return nil
+}
+
+// ResolvePseudoNumericChecked resolves a pseudo-numeric market to a value, first refusing values far from the current
+// value of the market (see CheckPseudoNumericResolution) unless force is set.
+//
+// Parameters:
+//   - id: The ID of the market to resolve. Required.
+//   - value: The final value of the market. Required.
+//   - probabilityInt: The probability integer (0-100) corresponding to the value on the market's scale. Required.
+//   - maxDistance: The largest accepted distance (between 0 and 1) from the current value, as a fraction of the range. Required.
+//   - force: If true, skips the value check entirely. Required.
+//
+// Returns:
+//   - *LiteMarket: A pointer to the resolved market object.
+//   - error: An error object if the check fails, the request fails, or input validation fails.
+func (s *MarketService) ResolvePseudoNumericChecked(id string, value float64, probabilityInt int, maxDistance float64, force bool) (*LiteMarket, error) {
+	if err := checkInRange(probabilityInt, 0, 100); err != nil {
+		return nil, fmt.Errorf("Market: ResolvePseudoNumericChecked(probabilityInt): %w", err)
+	}
+
+	if !force {
+		if err := s.CheckPseudoNumericResolution(id, value, maxDistance); err != nil {
+			return nil, fmt.Errorf("Market: ResolvePseudoNumericChecked: %w", err)
+		}
+	}
+
+	params := map[string]interface{}{
+		"outcome":        "MKT",
+		"value":          value,
+		"probabilityInt": probabilityInt,
+	}
+
+	return s.resolveMarket(id, params)
+}
+
 // Sell sells shares in a market.
 //
 // Parameters:
@@ -808,17 +982,9 @@ func (s *MarketService) Sell(id string, outcome *string, shares *float64, answer
 		body["answerId"] = *answerID
 	}
 
-	result, err := s.client.POST(
-		fmt.Sprintf("/market/%s/sell", url.PathEscape(id)), body,
-	)
-	if err != nil {
-		return nil, fmt.Errorf("Market: Sell: %w: %w", ErrorPOSTFailed, err)
-	}
-
-	bet := new(Bet)
-	err = json.Unmarshal(result, bet)
+	bet, err := PostJSON[*Bet](s.client, fmt.Sprintf("/market/%s/sell", url.PathEscape(id)), body)
 	if err != nil {
-		return nil, fmt.Errorf("Market: Sell: %w: %w", ErrorFailedToParseResponse, err)
+		return nil, fmt.Errorf("Market: Sell: %w", err)
 	}
 
 	return bet, nil
@@ -1,7 +1,6 @@
 package manifold
 
 import (
-	"encoding/json"
 	"fmt"
 	"net/url"
 )
@@ -36,17 +35,9 @@ func (s *UserService) Users(limit *int, before *string) ([]User, error) {
 		params["before"] = *before
 	}
 
-	result, err := s.client.GET(
-		"/users", params,
-	)
+	users, err := GetJSON[[]User](s.client, "/users", params)
 	if err != nil {
-		return nil, fmt.Errorf("User: Users: %w: %w", ErrorGETFailed, err)
-	}
-
-	users := make([]User, 0)
-	err = json.Unmarshal(result, &users)
-	if err != nil {
-		return nil, fmt.Errorf("User: Users: %w: %w", ErrorFailedToParseResponse, err)
+		return nil, fmt.Errorf("User: Users: %w", err)
 	}
 
 	return users, nil
@@ -61,17 +52,9 @@ func (s *UserService) Users(limit *int, before *string) ([]User, error) {
 //   - *User: A pointer to the retrieved user object.
 //   - error: An error object if the request fails or if the response cannot be parsed.
 func (s *UserService) User(username string) (*User, error) {
-	result, err := s.client.GET(
-		fmt.Sprintf("/user/%s", url.PathEscape(username)), nil,
-	)
+	user, err := GetJSON[*User](s.client, fmt.Sprintf("/user/%s", url.PathEscape(username)), nil)
 	if err != nil {
-		return nil, fmt.Errorf("User: User: %w: %w", ErrorGETFailed, err)
-	}
-
-	user := new(User)
-	err = json.Unmarshal(result, user)
-	if err != nil {
-		return nil, fmt.Errorf("User: User: %w: %w", ErrorFailedToParseResponse, err)
+		return nil, fmt.Errorf("User: User: %w", err)
 	}
 
 	return user, nil
@@ -86,17 +69,9 @@ func (s *UserService) User(username string) (*User, error) {
 //   - *DisplayUser: A pointer to the retrieved display user object, containing basic information.
 //   - error: An error object if the request fails or if the response cannot be parsed.
 func (s *UserService) UserLite(username string) (*DisplayUser, error) {
-	result, err := s.client.GET(
-		fmt.Sprintf("/user/%s/lite", url.PathEscape(username)), nil,
-	)
-	if err != nil {
-		return nil, fmt.Errorf("User: UserLite: %w: %w", ErrorGETFailed, err)
-	}
-
-	user := new(DisplayUser)
-	err = json.Unmarshal(result, user)
+	user, err := GetJSON[*DisplayUser](s.client, fmt.Sprintf("/user/%s/lite", url.PathEscape(username)), nil)
 	if err != nil {
-		return nil, fmt.Errorf("User: UserLite: %w: %w", ErrorFailedToParseResponse, err)
+		return nil, fmt.Errorf("User: UserLite: %w", err)
 	}
 
 	return user, nil
@@ -111,17 +86,9 @@ func (s *UserService) UserLite(username string) (*DisplayUser, error) {
 //   - *User: A pointer to the retrieved user object.
 //   - error: An error object if the request fails or if the response cannot be parsed.
 func (s *UserService) ID(id string) (*User, error) {
-	result, err := s.client.GET(
-		fmt.Sprintf("/user/by-id/%s", url.PathEscape(id)), nil,
-	)
+	user, err := GetJSON[*User](s.client, fmt.Sprintf("/user/by-id/%s", url.PathEscape(id)), nil)
 	if err != nil {
-		return nil, fmt.Errorf("User: ID: %w: %w", ErrorGETFailed, err)
-	}
-
-	user := new(User)
-	err = json.Unmarshal(result, user)
-	if err != nil {
-		return nil, fmt.Errorf("User: ID: %w: %w", ErrorFailedToParseResponse, err)
+		return nil, fmt.Errorf("User: ID: %w", err)
 	}
 
 	return user, nil
@@ -136,17 +103,9 @@ func (s *UserService) ID(id string) (*User, error) {
 //   - *DisplayUser: A pointer to the retrieved display user object, containing basic information.
 //   - error: An error object if the request fails or if the response cannot be parsed.
 func (s *UserService) IDLite(id string) (*DisplayUser, error) {
-	result, err := s.client.GET(
-		fmt.Sprintf("/user/by-id/%s/lite", url.PathEscape(id)), nil,
-	)
+	user, err := GetJSON[*DisplayUser](s.client, fmt.Sprintf("/user/by-id/%s/lite", url.PathEscape(id)), nil)
 	if err != nil {
-		return nil, fmt.Errorf("User: IDLite: %w: %w", ErrorGETFailed, err)
-	}
-
-	user := new(DisplayUser)
-	err = json.Unmarshal(result, user)
-	if err != nil {
-		return nil, fmt.Errorf("User: IDLite: %w: %w", ErrorFailedToParseResponse, err)
+		return nil, fmt.Errorf("User: IDLite: %w", err)
 	}
 
 	return user, nil
@@ -158,17 +117,9 @@ func (s *UserService) IDLite(id string) (*DisplayUser, error) {
 //   - *User: A pointer to the authenticated user's object.
 //   - error: An error object if the request fails or if the response cannot be parsed.
 func (s *UserService) Me() (*User, error) {
-	result, err := s.client.GET(
-		"/me", nil,
-	)
-	if err != nil {
-		return nil, fmt.Errorf("User: Me: %w: %w", ErrorGETFailed, err)
-	}
-
-	user := new(User)
-	err = json.Unmarshal(result, user)
+	user, err := GetJSON[*User](s.client, "/me", nil)
 	if err != nil {
-		return nil, fmt.Errorf("User: Me: %w: %w", ErrorFailedToParseResponse, err)
+		return nil, fmt.Errorf("User: Me: %w", err)
 	}
 
 	return user, nil
@@ -0,0 +1,217 @@
+package manifold
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// SimilarMarket is a market found by a SimilaritySearcher, with its similarity to the query.
+type SimilarMarket struct {
+	Market LiteMarket // The similar market
+	Score  float64    // Similarity to the query, between 0 and 1
+}
+
+// SimilaritySearcher ranks markets by how similar their questions are to a query, for duplicate detection.
+type SimilaritySearcher interface {
+	Similar(query string, candidates []LiteMarket) ([]SimilarMarket, error)
+}
+
+// Embedder computes vector embeddings of texts, such as an external embedding model or semantic search backend.
+type Embedder interface {
+	Embed(texts []string) ([][]float64, error)
+}
+
+// EmbeddingSearcher is a SimilaritySearcher that compares embeddings by cosine similarity.
+type EmbeddingSearcher struct {
+	Embedder Embedder // Backend computing the embeddings
+}
+
+// Similar ranks the candidates by the cosine similarity of their question's embedding to the query's.
+//
+// Parameters:
+//   - query: The text to compare against, usually a question. Required.
+//   - candidates: The markets to rank. Required.
+//
+// Returns:
+//   - []SimilarMarket: The candidates, most similar first.
+//   - error: An error object if the embedder fails or returns the wrong number of embeddings.
+func (s EmbeddingSearcher) Similar(query string, candidates []LiteMarket) ([]SimilarMarket, error) {
+	texts := make([]string, 0, len(candidates)+1)
+	texts = append(texts, query)
+	for _, market := range candidates {
+		texts = append(texts, market.Question)
+	}
+
+	embeddings, err := s.Embedder.Embed(texts)
+	if err != nil {
+		return nil, fmt.Errorf("EmbeddingSearcher: Similar: %w", err)
+	}
+
+	if len(embeddings) != len(texts) {
+		return nil, fmt.Errorf("EmbeddingSearcher: Similar: got %d embeddings for %d texts", len(embeddings), len(texts))
+	}
+
+	results := make([]SimilarMarket, len(candidates))
+	for i, market := range candidates {
+		results[i] = SimilarMarket{Market: market, Score: cosine(embeddings[0], embeddings[i+1])}
+	}
+
+	return sortSimilar(results), nil
+}
+
+// TFIDFSearcher is a local SimilaritySearcher that compares questions by the cosine similarity of their TF-IDF
+// weighted words, computed over the query and candidates. It needs no external service, but only matches on
+// shared words.
+type TFIDFSearcher struct{}
+
+// Similar ranks the candidates by the TF-IDF similarity of their question to the query.
+//
+// Parameters:
+//   - query: The text to compare against, usually a question. Required.
+//   - candidates: The markets to rank. Required.
+//
+// Returns:
+//   - []SimilarMarket: The candidates, most similar first.
+//   - error: Always nil.
+func (TFIDFSearcher) Similar(query string, candidates []LiteMarket) ([]SimilarMarket, error) {
+	documents := make([][]string, 0, len(candidates)+1)
+	documents = append(documents, tokenize(query))
+	for _, market := range candidates {
+		documents = append(documents, tokenize(market.Question))
+	}
+
+	frequency := make(map[string]int)
+	for _, document := range documents {
+		seen := make(map[string]bool)
+		for _, word := range document {
+			if !seen[word] {
+				seen[word] = true
+				frequency[word]++
+			}
+		}
+	}
+
+	vectors := make([]map[string]float64, len(documents))
+	for i, document := range documents {
+		vectors[i] = make(map[string]float64)
+		for _, word := range document {
+			vectors[i][word]++
+		}
+		for word, count := range vectors[i] {
+			idf := math.Log(float64(len(documents)+1)/float64(frequency[word]+1)) + 1
+			vectors[i][word] = count * idf
+		}
+	}
+
+	results := make([]SimilarMarket, len(candidates))
+	for i, market := range candidates {
+		results[i] = SimilarMarket{Market: market, Score: sparseCosine(vectors[0], vectors[i+1])}
+	}
+
+	return sortSimilar(results), nil
+}
+
+// stopWords are common words ignored by tokenize.
+var stopWords = map[string]bool{
+	"a": true, "an": true, "the": true, "will": true, "be": true, "by": true, "of": true, "in": true, "on": true,
+	"to": true, "is": true, "and": true, "or": true, "for": true, "at": true, "before": true, "end": true,
+}
+
+// tokenize splits text into lowercase words, dropping punctuation and stop words.
+func tokenize(text string) []string {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+
+	tokens := words[:0]
+	for _, word := range words {
+		if !stopWords[word] {
+			tokens = append(tokens, word)
+		}
+	}
+
+	return tokens
+}
+
+// cosine returns the cosine similarity of two dense vectors, or 0 if either is zero or their lengths differ.
+func cosine(a []float64, b []float64) float64 {
+	if len(a) != len(b) {
+		return 0
+	}
+
+	var dot, normA, normB float64
+	for i := range a {
+		dot += a[i] * b[i]
+		normA += a[i] * a[i]
+		normB += b[i] * b[i]
+	}
+
+	if normA == 0 || normB == 0 {
+		return 0
+	}
+
+	return dot / math.Sqrt(normA*normB)
+}
+
+// sparseCosine returns the cosine similarity of two sparse vectors, or 0 if either is zero.
+func sparseCosine(a map[string]float64, b map[string]float64) float64 {
+	var dot, normA, normB float64
+	for word, weight := range a {
+		dot += weight * b[word]
+		normA += weight * weight
+	}
+	for _, weight := range b {
+		normB += weight * weight
+	}
+
+	if normA == 0 || normB == 0 {
+		return 0
+	}
+
+	return dot / math.Sqrt(normA*normB)
+}
+
+// sortSimilar orders results by score, highest first.
+func sortSimilar(results []SimilarMarket) []SimilarMarket {
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].Score > results[j].Score
+	})
+
+	return results
+}
+
+// FindSimilar searches for markets matching the words of a question and ranks them by similarity, to detect
+// duplicates before creating a market.
+//
+// Parameters:
+//   - question: The question to find similar markets for. Required.
+//   - searcher: The similarity searcher, e.g. TFIDFSearcher{} or an EmbeddingSearcher. Required.
+//   - minScore: The smallest similarity to include. Required.
+//
+// Returns:
+//   - []SimilarMarket: The similar markets, most similar first.
+//   - error: An error object if a request or the searcher fails.
+func (s *MarketService) FindSimilar(question string, searcher SimilaritySearcher, minScore float64) ([]SimilarMarket, error) {
+	limit := 100
+	candidates, err := s.Search(strings.Join(tokenize(question), " "), nil, nil, nil, nil, nil, &limit, nil)
+	if err != nil {
+		return nil, fmt.Errorf("Market: FindSimilar: %w", err)
+	}
+
+	results, err := searcher.Similar(question, candidates)
+	if err != nil {
+		return nil, fmt.Errorf("Market: FindSimilar: %w", err)
+	}
+
+	filtered := results[:0]
+	for _, result := range results {
+		if result.Score >= minScore {
+			filtered = append(filtered, result)
+		}
+	}
+
+	return filtered, nil
+}
@@ -0,0 +1,100 @@
+package manifold
+
+import (
+	"fmt"
+	"math"
+)
+
+// CreateWithSlippage places a market order only if its estimated average price stays within a bound.
+// The price is estimated from the current pool of the market; the order is then placed with a limit at the estimated
+// final probability, rounded towards the current one, and any part of it left resting because the market moved in the
+// meantime is cancelled. As the estimate ignores fees, the filled bet is checked against the bound as well.
+//
+// Parameters:
+//   - amount: The amount of the bet. Required.
+//   - contractID: The ID of the binary market on which the bet is being placed. Required.
+//   - outcome: The outcome of the bet ("YES" or "NO"). Required.
+//   - maxAveragePrice: The highest acceptable average price per share of the bought outcome. Must be between 0 and 1. Required.
+//   - minShares: The fewest shares the order must buy. Optional.
+//   - downsize: If true, the amount is reduced to the largest amount within the bounds instead of failing. Required.
+//
+// Returns:
+//   - *Bet: The created bet object, also returned if it filled beyond the bound after fees.
+//   - error: An error wrapping ErrorSlippage if the bounds cannot be met or the fill exceeded them, or an error object if a request or input validation fails.
+func (s *BetService) CreateWithSlippage(amount float64, contractID string, outcome string, maxAveragePrice float64, minShares *float64, downsize bool) (*Bet, error) {
+	if err := checkInRange(maxAveragePrice, 0, 1); err != nil {
+		return nil, fmt.Errorf("Bet: CreateWithSlippage(maxAveragePrice): %w", err)
+	}
+
+	market, err := s.client.Market.Market(contractID)
+	if err != nil {
+		return nil, fmt.Errorf("Bet: CreateWithSlippage: %w", err)
+	}
+
+	within := func(quote *Quote) bool {
+		return quote.AveragePrice <= maxAveragePrice && (minShares == nil || quote.Shares >= *minShares)
+	}
+
+	quote, err := QuoteBuy(&market.LiteMarket, outcome, amount)
+	if err != nil {
+		return nil, fmt.Errorf("Bet: CreateWithSlippage: %w", err)
+	}
+
+	if !within(quote) {
+		if !downsize {
+			return nil, fmt.Errorf("Bet: CreateWithSlippage: %w: average price %.4f for %.2f shares", ErrorSlippage, quote.AveragePrice, quote.Shares)
+		}
+
+		// The average price grows with the amount, so search for the largest amount within the bounds.
+		low, high := 0.0, amount
+		for i := 0; i < 50; i++ {
+			mid := (low + high) / 2
+			q, err := QuoteBuy(&market.LiteMarket, outcome, mid)
+			if err == nil && q.AveragePrice <= maxAveragePrice {
+				low = mid
+			} else {
+				high = mid
+			}
+		}
+
+		amount = math.Floor(low)
+		if amount < 1 {
+			return nil, fmt.Errorf("Bet: CreateWithSlippage: %w: no amount within the price bound", ErrorSlippage)
+		}
+
+		quote, err = QuoteBuy(&market.LiteMarket, outcome, amount)
+		if err != nil {
+			return nil, fmt.Errorf("Bet: CreateWithSlippage: %w", err)
+		}
+
+		if !within(quote) {
+			return nil, fmt.Errorf("Bet: CreateWithSlippage: %w: average price %.4f for %.2f shares", ErrorSlippage, quote.AveragePrice, quote.Shares)
+		}
+	}
+
+	// Round the limit towards the current probability, so rounding can only cut the fill short rather than let it run
+	// past the estimated final probability and the price bound.
+	limit := math.Floor(quote.ProbAfter*100) / 100
+	if outcome == "NO" {
+		limit = math.Ceil(quote.ProbAfter*100) / 100
+	}
+	limit = math.Min(math.Max(limit, 0.01), 0.99)
+
+	bet, err := s.Create(amount, contractID, &outcome, &limit, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("Bet: CreateWithSlippage: %w", err)
+	}
+
+	if bet.LimitProps != nil && !bet.LimitProps.IsFilled && !bet.LimitProps.IsCancelled {
+		if err := s.Cancel(bet.ID); err != nil {
+			return bet, fmt.Errorf("Bet: CreateWithSlippage: cancelling unfilled remainder: %w", err)
+		}
+	}
+
+	// The estimate ignores fees, so the price actually paid is checked against the bound too.
+	if bet.Shares > 0 && bet.Amount/bet.Shares > maxAveragePrice {
+		return bet, fmt.Errorf("Bet: CreateWithSlippage: %w: filled at average price %.4f including fees", ErrorSlippage, bet.Amount/bet.Shares)
+	}
+
+	return bet, nil
+}
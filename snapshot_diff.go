@@ -0,0 +1,132 @@
+package manifold
+
+import (
+	"math"
+	"sort"
+)
+
+// MarketChangeKind is the kind of change to a market between two snapshots.
+type MarketChangeKind string
+
+const (
+	MarketChangeCreated  MarketChangeKind = "CREATED"  // The market is only in the later snapshot
+	MarketChangeResolved MarketChangeKind = "RESOLVED" // The market resolved between the snapshots
+	MarketChangeEdited   MarketChangeKind = "EDITED"   // The question, close time or resolution of the market changed
+	MarketChangeMoved    MarketChangeKind = "MOVED"    // The probability of the market moved by at least the threshold
+	MarketChangeRemoved  MarketChangeKind = "REMOVED"  // The market is only in the earlier snapshot
+)
+
+// marketChangeOrder is the order kinds of changes are listed in.
+var marketChangeOrder = map[MarketChangeKind]int{
+	MarketChangeCreated:  0,
+	MarketChangeResolved: 1,
+	MarketChangeEdited:   2,
+	MarketChangeMoved:    3,
+	MarketChangeRemoved:  4,
+}
+
+// MarketChange is an entry of the changelog between two snapshots of markets.
+type MarketChange struct {
+	Kind       MarketChangeKind `json:"kind"`                 // Kind of change
+	ContractID string           `json:"contractId"`           // ID of the market
+	Question   string           `json:"question"`             // Question of the market, as of the later snapshot if present
+	URL        string           `json:"url"`                  // URL of the market, as of the later snapshot if present
+	Fields     []string         `json:"fields,omitempty"`     // Fields that changed, for EDITED changes
+	ProbBefore *float64         `json:"probBefore,omitempty"` // Probability in the earlier snapshot, for MOVED and RESOLVED changes (optional)
+	ProbAfter  *float64         `json:"probAfter,omitempty"`  // Probability in the later snapshot, for MOVED and CREATED changes (optional)
+	Resolution *string          `json:"resolution,omitempty"` // Resolution of the market, for RESOLVED changes (optional)
+}
+
+// DiffSnapshots compares two snapshots of markets, such as two crawls of the whole site with MarketsPage, into a
+// changelog suitable for digests or for saving as JSON. A market can have several changes, e.g. when it was both
+// edited and resolved. Moves of markets that resolved are not reported, as the resolution already covers them.
+// Removed markets are only meaningful if both snapshots are complete.
+//
+// Parameters:
+//   - before: The earlier snapshot. Required.
+//   - after: The later snapshot. Required.
+//   - minMove: The smallest absolute change in probability reported as a move, e.g. 0.1. Markets whose probability
+//     did not change are never reported as moved, even if it is 0. Required.
+//
+// Returns:
+//   - []MarketChange: The changes, grouped by kind in the order of the MarketChange constants. Moves are ordered from
+//     largest to smallest, and other changes by market ID.
+func DiffSnapshots(before []LiteMarket, after []LiteMarket, minMove float64) []MarketChange {
+	previous := make(map[string]LiteMarket, len(before))
+	for _, market := range before {
+		previous[market.ID] = market
+	}
+
+	var changes []MarketChange
+	change := func(kind MarketChangeKind, market LiteMarket) MarketChange {
+		return MarketChange{Kind: kind, ContractID: market.ID, Question: market.Question, URL: market.URL}
+	}
+
+	current := make(map[string]bool, len(after))
+	for _, market := range after {
+		current[market.ID] = true
+
+		old, ok := previous[market.ID]
+		if !ok {
+			created := change(MarketChangeCreated, market)
+			created.ProbAfter = market.Probability
+			changes = append(changes, created)
+			continue
+		}
+
+		if market.IsResolved && !old.IsResolved {
+			resolved := change(MarketChangeResolved, market)
+			resolved.ProbBefore = old.Probability
+			resolved.Resolution = market.Resolution
+			changes = append(changes, resolved)
+		}
+
+		var fields []string
+		if market.Question != old.Question {
+			fields = append(fields, "question")
+		}
+		if !equalPtr(market.CloseTime, old.CloseTime) {
+			fields = append(fields, "closeTime")
+		}
+		if old.IsResolved && (!market.IsResolved || !equalPtr(market.Resolution, old.Resolution)) {
+			fields = append(fields, "resolution")
+		}
+		if len(fields) > 0 {
+			edited := change(MarketChangeEdited, market)
+			edited.Fields = fields
+			changes = append(changes, edited)
+		}
+
+		if !market.IsResolved && market.Probability != nil && old.Probability != nil {
+			if move := math.Abs(*market.Probability - *old.Probability); move > 0 && move >= minMove {
+				moved := change(MarketChangeMoved, market)
+				moved.ProbBefore = old.Probability
+				moved.ProbAfter = market.Probability
+				changes = append(changes, moved)
+			}
+		}
+	}
+
+	for _, market := range before {
+		if !current[market.ID] {
+			changes = append(changes, change(MarketChangeRemoved, market))
+		}
+	}
+
+	sort.SliceStable(changes, func(i, j int) bool {
+		a, b := changes[i], changes[j]
+		if a.Kind != b.Kind {
+			return marketChangeOrder[a.Kind] < marketChangeOrder[b.Kind]
+		}
+		if a.Kind == MarketChangeMoved {
+			moveA := math.Abs(*a.ProbAfter - *a.ProbBefore)
+			moveB := math.Abs(*b.ProbAfter - *b.ProbBefore)
+			if moveA != moveB {
+				return moveA > moveB
+			}
+		}
+		return a.ContractID < b.ContractID
+	})
+
+	return changes
+}
@@ -0,0 +1,59 @@
+package manifold
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDiffSnapshots(t *testing.T) {
+	market := func(id string, prob float64) LiteMarket {
+		return LiteMarket{ID: id, Question: "Q " + id, Probability: &prob}
+	}
+	resolved := func(m LiteMarket, resolution string) LiteMarket {
+		m.IsResolved = true
+		m.Resolution = &resolution
+		return m
+	}
+	edited := func(m LiteMarket, question string) LiteMarket {
+		m.Question = question
+		return m
+	}
+
+	tests := []struct {
+		name    string
+		before  []LiteMarket
+		after   []LiteMarket
+		minMove float64
+		want    []MarketChangeKind
+		ids     []string
+	}{
+		{"no changes", []LiteMarket{market("a", 0.5)}, []LiteMarket{market("a", 0.5)}, 0.1, nil, nil},
+		{"unchanged with zero threshold", []LiteMarket{market("a", 0.5)}, []LiteMarket{market("a", 0.5)}, 0, nil, nil},
+		{"small move with zero threshold", []LiteMarket{market("a", 0.5)}, []LiteMarket{market("a", 0.51)}, 0,
+			[]MarketChangeKind{MarketChangeMoved}, []string{"a"}},
+		{"move below threshold", []LiteMarket{market("a", 0.5)}, []LiteMarket{market("a", 0.55)}, 0.1, nil, nil},
+		{"moves ordered by size", []LiteMarket{market("a", 0.5), market("b", 0.5)}, []LiteMarket{market("a", 0.7), market("b", 0.2)}, 0.1,
+			[]MarketChangeKind{MarketChangeMoved, MarketChangeMoved}, []string{"b", "a"}},
+		{"resolution covers the move", []LiteMarket{market("a", 0.5)}, []LiteMarket{resolved(market("a", 1), "YES")}, 0.1,
+			[]MarketChangeKind{MarketChangeResolved}, []string{"a"}},
+		{"edited", []LiteMarket{market("a", 0.5)}, []LiteMarket{edited(market("a", 0.5), "New")}, 0.1,
+			[]MarketChangeKind{MarketChangeEdited}, []string{"a"}},
+		{"created and removed", []LiteMarket{market("a", 0.5)}, []LiteMarket{market("b", 0.5)}, 0.1,
+			[]MarketChangeKind{MarketChangeCreated, MarketChangeRemoved}, []string{"b", "a"}},
+	}
+
+	for _, tt := range tests {
+		changes := DiffSnapshots(tt.before, tt.after, tt.minMove)
+
+		var kinds []MarketChangeKind
+		var ids []string
+		for _, change := range changes {
+			kinds = append(kinds, change.Kind)
+			ids = append(ids, change.ContractID)
+		}
+
+		if !reflect.DeepEqual(kinds, tt.want) || !reflect.DeepEqual(ids, tt.ids) {
+			t.Errorf("%s: DiffSnapshots() = %v %v, want %v %v", tt.name, kinds, ids, tt.want, tt.ids)
+		}
+	}
+}
@@ -0,0 +1,220 @@
+package manifold
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+)
+
+// SpamWeights are the weights of the components of a comment's spam score.
+type SpamWeights struct {
+	NewAccount float64 // Weight of how new the commenter's account is, from 1 for brand new to 0 at AccountAge
+	Inactive   float64 // Weight of how little the commenter has done elsewhere, from 1 for nothing to 0 at Activity
+	Links      float64 // Weight of the fraction of words that are links
+	Duplicate  float64 // Weight of log(1 + copies of the comment on other markets)
+
+	AccountAge time.Duration // Account age from which an account is no longer considered new
+	Activity   int           // Number of prior bets and comments from which a user is no longer considered inactive
+}
+
+// DefaultSpamWeights are weights that flag new, inactive accounts posting links or the same comment everywhere.
+var DefaultSpamWeights = SpamWeights{
+	NewAccount: 1,
+	Inactive:   1,
+	Links:      2,
+	Duplicate:  1.5,
+	AccountAge: 7 * 24 * time.Hour,
+	Activity:   20,
+}
+
+// SpamInputs are the signals about a comment that its spam score is computed from.
+type SpamInputs struct {
+	Comment    Comment       // The comment
+	AccountAge time.Duration // Age of the commenter's account when the comment was posted
+	Bets       int           // Number of bets placed by the commenter, capped by the lookup limit
+	Comments   int           // Number of other comments posted by the commenter, capped by the lookup limit
+	Words      int           // Number of words in the comment
+	Links      int           // Number of links in the comment
+	Duplicates int           // Number of comments by the commenter with the same text on other markets
+}
+
+// SpamScore is the spam score of a comment.
+type SpamScore struct {
+	SpamInputs
+
+	Score float64 // Weighted sum of the components, higher meaning more likely spam
+}
+
+// ScoreComment computes the spam score of a comment. The score is only a heuristic to rank comments for review,
+// not a verdict.
+//
+// Parameters:
+//   - inputs: The signals about the comment. Required.
+//   - weights: The weights of the components, e.g. DefaultSpamWeights. Required.
+//
+// Returns:
+//   - SpamScore: The spam score of the comment.
+func ScoreComment(inputs SpamInputs, weights SpamWeights) SpamScore {
+	var score float64
+
+	if weights.AccountAge > 0 && inputs.AccountAge < weights.AccountAge {
+		score += weights.NewAccount * (1 - float64(inputs.AccountAge)/float64(weights.AccountAge))
+	}
+
+	if activity := inputs.Bets + inputs.Comments; weights.Activity > 0 && activity < weights.Activity {
+		score += weights.Inactive * (1 - float64(activity)/float64(weights.Activity))
+	}
+
+	if inputs.Words > 0 {
+		score += weights.Links * math.Min(float64(inputs.Links)/float64(inputs.Words), 1)
+	}
+
+	score += weights.Duplicate * math.Log1p(float64(inputs.Duplicates))
+
+	return SpamScore{SpamInputs: inputs, Score: score}
+}
+
+// RankBySpam computes the spam scores of several comments and orders them.
+//
+// Parameters:
+//   - inputs: The signals about every comment. Required.
+//   - weights: The weights of the components, e.g. DefaultSpamWeights. Required.
+//
+// Returns:
+//   - []SpamScore: The spam scores, most likely spam first.
+func RankBySpam(inputs []SpamInputs, weights SpamWeights) []SpamScore {
+	scores := make([]SpamScore, len(inputs))
+	for i, in := range inputs {
+		scores[i] = ScoreComment(in, weights)
+	}
+
+	sort.SliceStable(scores, func(i, j int) bool {
+		return scores[i].Score > scores[j].Score
+	})
+
+	return scores
+}
+
+// SpamInputs gathers the spam signals of the comments posted on a market since a point in time. Each commenter's
+// account, bets and comments are looked up once, with prior activity counted up to 100 bets and 100 comments.
+//
+// Parameters:
+//   - contractID: The ID of the market. Required.
+//   - since: Only comments posted after this time are included. Required.
+//
+// Returns:
+//   - []SpamInputs: The signals of each comment, oldest first.
+//   - error: An error object if a request fails.
+func (s *CommentService) SpamInputs(contractID string, since time.Time) ([]SpamInputs, error) {
+	comments, err := s.Since(contractID, since)
+	if err != nil {
+		return nil, fmt.Errorf("Comment: SpamInputs: %w", err)
+	}
+
+	type commenter struct {
+		createdTime int64
+		bets        int
+		comments    []Comment
+	}
+	commenters := make(map[string]*commenter)
+	limit := 100
+
+	inputs := make([]SpamInputs, 0, len(comments))
+	for _, comment := range comments {
+		c, ok := commenters[comment.UserID]
+		if !ok {
+			user, err := s.client.User.ID(comment.UserID)
+			if err != nil {
+				return nil, fmt.Errorf("Comment: SpamInputs: %w", err)
+			}
+
+			bets, err := s.client.Bet.Bets(&comment.UserID, nil, nil, nil, &limit, nil, nil, nil, nil, nil, nil)
+			if err != nil {
+				return nil, fmt.Errorf("Comment: SpamInputs: %w", err)
+			}
+
+			history, err := s.Comments(nil, nil, &limit, nil, &comment.UserID)
+			if err != nil {
+				return nil, fmt.Errorf("Comment: SpamInputs: %w", err)
+			}
+
+			c = &commenter{createdTime: user.CreatedTime, bets: len(bets), comments: history}
+			commenters[comment.UserID] = c
+		}
+
+		text := commentText(comment)
+		in := SpamInputs{
+			Comment:    comment,
+			AccountAge: time.Duration(comment.CreatedTime-c.createdTime) * time.Millisecond,
+			Bets:       c.bets,
+		}
+
+		for _, other := range c.comments {
+			if other.ID == comment.ID {
+				continue
+			}
+			in.Comments++
+
+			if other.ContractID != comment.ContractID && text != "" && commentText(other) == text {
+				in.Duplicates++
+			}
+		}
+
+		for _, word := range strings.Fields(text) {
+			in.Words++
+			if strings.HasPrefix(word, "http://") || strings.HasPrefix(word, "https://") || strings.HasPrefix(word, "www.") {
+				in.Links++
+			}
+		}
+
+		// Links with anchor text do not show up in the plain text, so count them from the content.
+		if refs, err := commentLinks(comment.Content); err == nil && refs > in.Links {
+			in.Links = refs
+		}
+
+		inputs = append(inputs, in)
+	}
+
+	return inputs, nil
+}
+
+// commentText returns the normalized plain text of a comment, falling back to its deprecated text field.
+func commentText(comment Comment) string {
+	text, err := PlainText(comment.Content)
+	if (err != nil || text == "") && comment.Text != nil {
+		text = *comment.Text
+	}
+
+	return strings.Join(strings.Fields(strings.ToLower(text)), " ")
+}
+
+// commentLinks counts the link marks in TipTap content.
+func commentLinks(content json.RawMessage) (int, error) {
+	if len(content) == 0 {
+		return 0, nil
+	}
+
+	var root tiptapNode
+	if err := json.Unmarshal(content, &root); err != nil {
+		return 0, err
+	}
+
+	var count int
+	var walk func(n tiptapNode)
+	walk = func(n tiptapNode) {
+		for _, mark := range n.Marks {
+			if mark.Type == "link" {
+				count++
+			}
+		}
+		for _, child := range n.Content {
+			walk(child)
+		}
+	}
+	walk(root)
+
+	return count, nil
+}
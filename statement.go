@@ -0,0 +1,209 @@
+package manifold
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// StatementLine is the result of a single market in a YearlyStatement.
+type StatementLine struct {
+	ContractID string    // ID of the market
+	Question   string    // Question of the market
+	ResolvedAt time.Time // Time the market resolved
+	Invested   float64   // Net amount spent on the market, after sales
+	Payout     float64   // Amount paid out on resolution
+	Result     float64   // Payout - Invested
+}
+
+// YearlyStatement summarizes the results of a user's bets on markets resolved within a calendar year.
+type YearlyStatement struct {
+	Year          int             // Calendar year of the statement
+	Token         string          // Token the amounts are in ("MANA" or "CASH")
+	TotalStaked   float64         // Total amount spent on bets placed during the year
+	Fees          float64         // Total fees paid on bets placed during the year
+	GrossWinnings float64         // Sum of the results of winning markets resolved during the year
+	Losses        float64         // Sum of the absolute results of losing markets resolved during the year
+	NetResult     float64         // GrossWinnings - Losses
+	Markets       []StatementLine // Results of every market resolved during the year, in order of resolution
+	LargestWins   []StatementLine // Up to five markets with the largest wins
+	LargestLosses []StatementLine // Up to five markets with the largest losses
+}
+
+// BuildStatement builds the yearly statement of a user from their bets and the markets they bet on.
+// Markets count towards the year in which they resolved, while stakes and fees count towards the year the bet was placed.
+// Only markets trading in the given token are included, as amounts in different tokens cannot be added up.
+//
+// Parameters:
+//   - year: The calendar year of the statement. Required.
+//   - loc: The time zone that years are delimited in. Required.
+//   - token: The token of the markets to include (TokenMana or TokenCash). Required.
+//   - bets: The bets placed by a single user. Required.
+//   - markets: The markets the bets were placed in, keyed by market ID. Required.
+//
+// Returns:
+//   - *YearlyStatement: A pointer to the statement.
+func BuildStatement(year int, loc *time.Location, token string, bets []Bet, markets map[string]*FullMarket) *YearlyStatement {
+	bets = betsInToken(bets, markets, token)
+
+	start := time.Date(year, time.January, 1, 0, 0, 0, 0, loc).UnixMilli()
+	end := time.Date(year+1, time.January, 1, 0, 0, 0, 0, loc).UnixMilli()
+
+	statement := &YearlyStatement{Year: year, Token: token}
+	for _, bet := range bets {
+		if bet.CreatedTime >= start && bet.CreatedTime < end && bet.Amount > 0 {
+			statement.TotalStaked += bet.Amount
+			statement.Fees += bet.Fees.CreatorFee + bet.Fees.PlatformFee + bet.Fees.LiquidityFee
+		}
+	}
+
+	for _, line := range resolvedResults(bets, markets, start, end, loc) {
+		statement.Markets = append(statement.Markets, line)
+		if line.Result > 0 {
+			statement.GrossWinnings += line.Result
+		} else {
+			statement.Losses -= line.Result
+		}
+	}
+	statement.NetResult = statement.GrossWinnings - statement.Losses
+
+	sort.Slice(statement.Markets, func(i, j int) bool {
+		return statement.Markets[i].ResolvedAt.Before(statement.Markets[j].ResolvedAt)
+	})
+
+	byResult := make([]StatementLine, len(statement.Markets))
+	copy(byResult, statement.Markets)
+	sort.SliceStable(byResult, func(i, j int) bool {
+		return byResult[i].Result > byResult[j].Result
+	})
+
+	for i := 0; i < len(byResult) && i < 5 && byResult[i].Result > 0; i++ {
+		statement.LargestWins = append(statement.LargestWins, byResult[i])
+	}
+	for i := len(byResult) - 1; i >= 0 && len(statement.LargestLosses) < 5 && byResult[i].Result < 0; i-- {
+		statement.LargestLosses = append(statement.LargestLosses, byResult[i])
+	}
+
+	return statement
+}
+
+// resolvedResults returns the result of every market a user bet on that resolved within [start, end), in no particular order.
+// Answers of multiple choice markets are combined into a single line per market.
+func resolvedResults(bets []Bet, markets map[string]*FullMarket, start int64, end int64, loc *time.Location) []StatementLine {
+	positions := make(map[string]*HistoricPosition)
+	for _, bet := range bets {
+		key := bet.ContractID
+		if bet.AnswerID != nil {
+			key = *bet.AnswerID
+		}
+
+		position, ok := positions[key]
+		if !ok {
+			position = &HistoricPosition{ContractID: bet.ContractID, AnswerID: bet.AnswerID, Shares: make(map[string]float64)}
+			positions[key] = position
+		}
+
+		position.Shares[bet.Outcome] += bet.Shares
+		position.Invested += bet.Amount
+	}
+
+	lines := make(map[string]*StatementLine)
+	for _, position := range positions {
+		market, ok := markets[position.ContractID]
+		if !ok {
+			continue
+		}
+
+		resolution := marketResolution(market, position.AnswerID)
+		if resolution == nil || resolution.time < start || resolution.time >= end {
+			continue
+		}
+
+		resolvedAt := time.UnixMilli(resolution.time).In(loc)
+		line, ok := lines[market.ID]
+		if !ok {
+			line = &StatementLine{ContractID: market.ID, Question: market.Question, ResolvedAt: resolvedAt}
+			lines[market.ID] = line
+		}
+		if resolvedAt.After(line.ResolvedAt) {
+			line.ResolvedAt = resolvedAt
+		}
+
+		line.Invested += position.Invested
+		line.Payout += resolutionPayout(resolution, position)
+		line.Result = line.Payout - line.Invested
+	}
+
+	results := make([]StatementLine, 0, len(lines))
+	for _, line := range lines {
+		results = append(results, *line)
+	}
+
+	return results
+}
+
+// WriteCSV writes the per-market lines of the statement as CSV, with a header row.
+//
+// Parameters:
+//   - w: The writer to write the CSV to. Required.
+//
+// Returns:
+//   - error: An error object if writing fails.
+func (s *YearlyStatement) WriteCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+
+	if err := writer.Write([]string{"contract_id", "question", "resolved_at", "invested", "payout", "result"}); err != nil {
+		return err
+	}
+
+	format := func(v float64) string {
+		return strconv.FormatFloat(v, 'f', 2, 64)
+	}
+
+	for _, line := range s.Markets {
+		record := []string{line.ContractID, line.Question, line.ResolvedAt.Format(time.RFC3339), format(line.Invested), format(line.Payout), format(line.Result)}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+
+	return writer.Error()
+}
+
+// Statement builds the yearly statement of a user from their full bet history (see BuildStatement).
+//
+// Parameters:
+//   - userID: The ID of the user. Required.
+//   - year: The calendar year of the statement. Required.
+//   - loc: The time zone that years are delimited in. Required.
+//   - token: The token of the markets to include (TokenMana or TokenCash). Required.
+//
+// Returns:
+//   - *YearlyStatement: A pointer to the statement.
+//   - error: An error object if a request fails or if the response cannot be parsed.
+func (s *UserService) Statement(userID string, year int, loc *time.Location, token string) (*YearlyStatement, error) {
+	bets, err := s.client.Bet.allBets(&userID, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("User: Statement: %w", err)
+	}
+
+	markets := make(map[string]*FullMarket)
+	for _, bet := range bets {
+		if _, ok := markets[bet.ContractID]; ok {
+			continue
+		}
+
+		market, err := s.client.Market.Market(bet.ContractID)
+		if err != nil {
+			return nil, fmt.Errorf("User: Statement: %w", err)
+		}
+		markets[bet.ContractID] = market
+	}
+
+	return BuildStatement(year, loc, token, bets, markets), nil
+}
@@ -0,0 +1,108 @@
+package manifold
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestBuildStatement(t *testing.T) {
+	year := 2025
+	at := func(month time.Month) int64 { return time.Date(year, month, 1, 0, 0, 0, 0, time.UTC).UnixMilli() }
+	resolved := func(id string, resolution string, when int64) *FullMarket {
+		m := &FullMarket{}
+		m.ID, m.Question, m.IsResolved, m.Resolution, m.ResolutionTime = id, "Q "+id, true, &resolution, &when
+		return m
+	}
+
+	cash := resolved("cash", "YES", at(time.June))
+	cash.Token = ptr(TokenCash)
+	open := &FullMarket{}
+	open.ID = "open"
+	answer := "ans"
+	multi := &FullMarket{}
+	multi.ID, multi.Question = "multi", "Q multi"
+	multi.Answers = &[]ApiAnswer{{Answer: Answer{ID: answer, Resolution: ptr("YES"), ResolutionTime: ptr(at(time.September))}}}
+
+	markets := map[string]*FullMarket{
+		"win":   resolved("win", "YES", at(time.March)),
+		"loss":  resolved("loss", "NO", at(time.April)),
+		"sold":  resolved("sold", "NO", at(time.May)),
+		"late":  resolved("late", "YES", time.Date(year+1, time.February, 1, 0, 0, 0, 0, time.UTC).UnixMilli()),
+		"cash":  cash,
+		"open":  open,
+		"multi": multi,
+	}
+
+	bets := []Bet{
+		{ContractID: "win", Outcome: "YES", Amount: 10, Shares: 25, CreatedTime: at(time.January), Fees: Fees{PlatformFee: 1}},
+		{ContractID: "loss", Outcome: "YES", Amount: 20, Shares: 30, CreatedTime: time.Date(year-1, time.December, 1, 0, 0, 0, 0, time.UTC).UnixMilli()},
+		{ContractID: "sold", Outcome: "YES", Amount: 10, Shares: 20, CreatedTime: at(time.February)},
+		{ContractID: "sold", Outcome: "YES", Amount: -12, Shares: -20, CreatedTime: at(time.March)},
+		{ContractID: "late", Outcome: "YES", Amount: 5, Shares: 10, CreatedTime: at(time.March)},
+		{ContractID: "cash", Outcome: "YES", Amount: 100, Shares: 200, CreatedTime: at(time.March)},
+		{ContractID: "open", Outcome: "NO", Amount: 7, Shares: 10, CreatedTime: at(time.March)},
+		{ContractID: "multi", AnswerID: &answer, Outcome: "YES", Amount: 4, Shares: 8, CreatedTime: at(time.March)},
+	}
+
+	statement := BuildStatement(year, time.UTC, TokenMana, bets, markets)
+
+	// Stakes count bets placed during the year in the token, excluding sales.
+	if statement.TotalStaked != 36 || statement.Fees != 1 {
+		t.Errorf("staked %v with %v fees, want 36 with 1", statement.TotalStaked, statement.Fees)
+	}
+
+	want := []struct {
+		id     string
+		result float64
+	}{
+		{"win", 15},
+		{"loss", -20},
+		{"sold", 2},
+		{"multi", 4},
+	}
+	if len(statement.Markets) != len(want) {
+		t.Fatalf("statement has %d markets, want %d: %+v", len(statement.Markets), len(want), statement.Markets)
+	}
+	for i, line := range statement.Markets {
+		if line.ContractID != want[i].id || math.Abs(line.Result-want[i].result) > 1e-9 {
+			t.Errorf("line %d = %s %v, want %s %v", i, line.ContractID, line.Result, want[i].id, want[i].result)
+		}
+	}
+
+	if statement.GrossWinnings != 21 || statement.Losses != 20 || statement.NetResult != 1 {
+		t.Errorf("winnings %v, losses %v, net %v, want 21, 20, 1", statement.GrossWinnings, statement.Losses, statement.NetResult)
+	}
+	if len(statement.LargestWins) != 3 || statement.LargestWins[0].ContractID != "win" {
+		t.Errorf("largest wins = %+v, want win first of 3", statement.LargestWins)
+	}
+	if len(statement.LargestLosses) != 1 || statement.LargestLosses[0].ContractID != "loss" {
+		t.Errorf("largest losses = %+v, want loss only", statement.LargestLosses)
+	}
+}
+
+func TestResolvedResultsCombinesAnswers(t *testing.T) {
+	a, b := "a", "b"
+	resolvedAt := time.Date(2025, time.June, 1, 0, 0, 0, 0, time.UTC).UnixMilli()
+	market := &FullMarket{}
+	market.ID = "m"
+	market.Answers = &[]ApiAnswer{
+		{Answer: Answer{ID: a, Resolution: ptr("YES"), ResolutionTime: ptr(resolvedAt)}},
+		{Answer: Answer{ID: b, Resolution: ptr("NO"), ResolutionTime: ptr(resolvedAt + 1000)}},
+	}
+
+	bets := []Bet{
+		{ContractID: "m", AnswerID: &a, Outcome: "YES", Amount: 5, Shares: 10},
+		{ContractID: "m", AnswerID: &b, Outcome: "YES", Amount: 3, Shares: 6},
+	}
+
+	lines := resolvedResults(bets, map[string]*FullMarket{"m": market}, 0, resolvedAt*2, time.UTC)
+	if len(lines) != 1 {
+		t.Fatalf("resolvedResults() = %+v, want a single line", lines)
+	}
+
+	line := lines[0]
+	if line.Invested != 8 || line.Payout != 10 || line.Result != 2 || line.ResolvedAt.UnixMilli() != resolvedAt+1000 {
+		t.Errorf("line = %+v, want 8 invested, 10 paid out and the later resolution time", line)
+	}
+}
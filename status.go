@@ -0,0 +1,218 @@
+package manifold
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+// MarketStatusEventKind identifies the kind of change reported by a MarketStatusWatcher.
+type MarketStatusEventKind string
+
+const (
+	MarketClosed          MarketStatusEventKind = "MARKET_CLOSED"            // The market stopped accepting bets
+	MarketReopened        MarketStatusEventKind = "MARKET_REOPENED"          // A closed market started accepting bets again
+	MarketCloseTimeChange MarketStatusEventKind = "MARKET_CLOSE_TIME_CHANGE" // The close time of an open market changed
+	MarketResolved        MarketStatusEventKind = "MARKET_RESOLVED"          // The market was resolved
+	MarketAnswerAdded     MarketStatusEventKind = "MARKET_ANSWER_ADDED"      // An answer was added to a multiple choice market
+)
+
+// MarketStatusEvent reports a change in the status of a watched market.
+type MarketStatusEvent struct {
+	Kind   MarketStatusEventKind // Kind of change
+	Market *FullMarket           // State of the market when the change was detected
+	Answer *ApiAnswer            // Answer that was added, for MarketAnswerAdded events (optional)
+}
+
+// MarketStatusWatcher detects markets closing, reopening, changing close time, resolving, and gaining answers
+// by polling them.
+type MarketStatusWatcher struct {
+	client  *Client
+	onEvent func(MarketStatusEvent)
+
+	mu      sync.Mutex
+	markets map[string]*FullMarket
+	polled  map[string]time.Time
+}
+
+// NewMarketStatusWatcher creates a new market status watcher.
+//
+// Parameters:
+//   - client: The client used to poll markets. Required.
+//   - onEvent: Called for every detected change. Required.
+//
+// Returns:
+//   - *MarketStatusWatcher: A pointer to the newly created watcher, with no markets watched.
+func NewMarketStatusWatcher(client *Client, onEvent func(MarketStatusEvent)) *MarketStatusWatcher {
+	return &MarketStatusWatcher{
+		client:  client,
+		onEvent: onEvent,
+		markets: make(map[string]*FullMarket),
+		polled:  make(map[string]time.Time),
+	}
+}
+
+// Watch starts watching a market. Its current state is used as the baseline, so no event is emitted for it.
+//
+// Parameters:
+//   - id: The ID of the market to watch. Required.
+//
+// Returns:
+//   - error: An error object if the request fails or if the response cannot be parsed.
+func (w *MarketStatusWatcher) Watch(id string) error {
+	market, err := w.client.Market.Market(id)
+	if err != nil {
+		return fmt.Errorf("MarketStatusWatcher: Watch: %w", err)
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.markets[id] = market
+	w.polled[id] = w.client.Now()
+
+	return nil
+}
+
+// Unwatch stops watching a market.
+//
+// Parameters:
+//   - id: The ID of the market to stop watching. Required.
+func (w *MarketStatusWatcher) Unwatch(id string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	delete(w.markets, id)
+	delete(w.polled, id)
+}
+
+// Check polls every watched market once and emits events for any changes since the previous poll.
+// Resolved markets are no longer watched after their MarketResolved event.
+//
+// Returns:
+//   - error: An error object if a market could not be polled. Remaining markets are still checked.
+func (w *MarketStatusWatcher) Check() error {
+	w.mu.Lock()
+	ids := make([]string, 0, len(w.markets))
+	for id := range w.markets {
+		ids = append(ids, id)
+	}
+	w.mu.Unlock()
+
+	var firstErr error
+	for _, id := range ids {
+		market, err := w.client.Market.Market(id)
+		if err != nil {
+			if firstErr == nil {
+				firstErr = fmt.Errorf("MarketStatusWatcher: Check: %w", err)
+			}
+			continue
+		}
+
+		now := w.client.Now()
+
+		w.mu.Lock()
+		previous, ok := w.markets[id]
+		then := w.polled[id]
+		if ok {
+			w.markets[id] = market
+			w.polled[id] = now
+			if market.IsResolved {
+				delete(w.markets, id)
+				delete(w.polled, id)
+			}
+		}
+		w.mu.Unlock()
+
+		if !ok {
+			continue
+		}
+
+		for _, answer := range addedAnswers(previous, market) {
+			w.onEvent(MarketStatusEvent{Kind: MarketAnswerAdded, Market: market, Answer: answer})
+		}
+
+		for _, kind := range statusChanges(&previous.LiteMarket, then, &market.LiteMarket, now) {
+			w.onEvent(MarketStatusEvent{Kind: kind, Market: market})
+		}
+	}
+
+	return firstErr
+}
+
+// Run calls Check at the given interval until stop is closed. Errors from Check are passed to onError.
+//
+// Parameters:
+//   - interval: The time between checks. Required.
+//   - stop: A channel that stops the watcher when closed. Required.
+//   - onError: Called with every error returned by Check. Optional.
+func (w *MarketStatusWatcher) Run(interval time.Duration, stop <-chan struct{}, onError func(error)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := w.Check(); err != nil && onError != nil {
+			onError(err)
+		}
+
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// statusChanges lists the status changes between two states of the same market, polled at then and now. Each state
+// is judged open or closed at the time it was polled, so a market whose close time passes between polls is closed.
+func statusChanges(previous *LiteMarket, then time.Time, current *LiteMarket, now time.Time) []MarketStatusEventKind {
+	var changes []MarketStatusEventKind
+
+	isOpen := func(m *LiteMarket, at time.Time) bool {
+		return !m.IsResolved && (m.CloseTime == nil || at.UnixMilli() < *m.CloseTime)
+	}
+
+	wasOpen, open := isOpen(previous, then), isOpen(current, now)
+	switch {
+	case wasOpen && !open && !current.IsResolved:
+		changes = append(changes, MarketClosed)
+	case !wasOpen && open:
+		changes = append(changes, MarketReopened)
+	case open && !equalPtr(previous.CloseTime, current.CloseTime):
+		changes = append(changes, MarketCloseTimeChange)
+	}
+
+	if !previous.IsResolved && current.IsResolved {
+		changes = append(changes, MarketResolved)
+	}
+
+	return changes
+}
+
+// addedAnswers lists the answers of a market that were not present in its previous state, in order of their index.
+func addedAnswers(previous *FullMarket, current *FullMarket) []*ApiAnswer {
+	if current.Answers == nil {
+		return nil
+	}
+
+	known := make(map[string]bool)
+	if previous.Answers != nil {
+		for _, answer := range *previous.Answers {
+			known[answer.ID] = true
+		}
+	}
+
+	var added []*ApiAnswer
+	for i := range *current.Answers {
+		if answer := &(*current.Answers)[i]; !known[answer.ID] {
+			added = append(added, answer)
+		}
+	}
+
+	sort.Slice(added, func(i, j int) bool {
+		return added[i].Index < added[j].Index
+	})
+
+	return added
+}
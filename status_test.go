@@ -0,0 +1,70 @@
+package manifold
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestStatusChanges(t *testing.T) {
+	then := time.UnixMilli(1_000_000)
+	now := then.Add(time.Minute)
+	at := func(t time.Time) *int64 { return ptr(t.UnixMilli()) }
+
+	tests := []struct {
+		name     string
+		previous LiteMarket
+		current  LiteMarket
+		want     []MarketStatusEventKind
+	}{
+		{
+			name:     "unchanged open market",
+			previous: LiteMarket{CloseTime: at(now.Add(time.Hour))},
+			current:  LiteMarket{CloseTime: at(now.Add(time.Hour))},
+		},
+		{
+			name:     "close time passes between polls",
+			previous: LiteMarket{CloseTime: at(then.Add(30 * time.Second))},
+			current:  LiteMarket{CloseTime: at(then.Add(30 * time.Second))},
+			want:     []MarketStatusEventKind{MarketClosed},
+		},
+		{
+			name:     "close time moved earlier",
+			previous: LiteMarket{CloseTime: at(now.Add(time.Hour))},
+			current:  LiteMarket{CloseTime: at(then)},
+			want:     []MarketStatusEventKind{MarketClosed},
+		},
+		{
+			name:     "still closed",
+			previous: LiteMarket{CloseTime: at(then.Add(-time.Hour))},
+			current:  LiteMarket{CloseTime: at(then.Add(-time.Hour))},
+		},
+		{
+			name:     "reopened",
+			previous: LiteMarket{CloseTime: at(then.Add(-time.Hour))},
+			current:  LiteMarket{CloseTime: at(now.Add(time.Hour))},
+			want:     []MarketStatusEventKind{MarketReopened},
+		},
+		{
+			name:     "close time extended",
+			previous: LiteMarket{CloseTime: at(now.Add(time.Hour))},
+			current:  LiteMarket{CloseTime: at(now.Add(2 * time.Hour))},
+			want:     []MarketStatusEventKind{MarketCloseTimeChange},
+		},
+		{
+			name:     "resolved while open",
+			previous: LiteMarket{CloseTime: at(now.Add(time.Hour))},
+			current:  LiteMarket{CloseTime: at(now.Add(time.Hour)), IsResolved: true},
+			want:     []MarketStatusEventKind{MarketResolved},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := statusChanges(&tt.previous, then, &tt.current, now)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("statusChanges() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
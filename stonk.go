@@ -0,0 +1,90 @@
+package manifold
+
+import (
+	"fmt"
+	"math"
+)
+
+// Stonk markets are perpetual binary markets that never resolve. They trade YES ("buy") and NO ("short") shares
+// like a binary market, but are displayed as a price derived from the log odds of their probability.
+const (
+	stonkPriceScale  = 10 // Price change per unit of log odds
+	stonkPriceOffset = 50 // Price at a probability of 50%
+	stonkMinPrice    = 0.01
+)
+
+// IsStonk reports whether a market is a perpetual stonk market, which never resolves.
+// Analytics that assume markets eventually resolve should exclude these markets.
+func (m *LiteMarket) IsStonk() bool {
+	return m.OutcomeType == "STONK"
+}
+
+// StonkPrice converts the probability of a stonk market to its displayed price.
+//
+// Parameters:
+//   - prob: The probability of the market. Required.
+//
+// Returns:
+//   - float64: The price of the stonk.
+func StonkPrice(prob float64) float64 {
+	prob = math.Min(math.Max(prob, 0.0001), 0.9999)
+
+	return math.Max(stonkPriceScale*math.Log(prob/(1-prob))+stonkPriceOffset, stonkMinPrice)
+}
+
+// StonkProb converts the displayed price of a stonk market to its probability. It is the inverse of StonkPrice.
+//
+// Parameters:
+//   - price: The price of the stonk. Required.
+//
+// Returns:
+//   - float64: The probability of the market.
+func StonkProb(price float64) float64 {
+	return 1 / (1 + math.Exp(-(price-stonkPriceOffset)/stonkPriceScale))
+}
+
+// Price returns the current displayed price of a stonk market.
+//
+// Returns:
+//   - float64: The price of the stonk.
+//   - error: An error object if the market is not a stonk market or has no probability.
+func (m *LiteMarket) Price() (float64, error) {
+	if !m.IsStonk() || m.Probability == nil {
+		return 0, fmt.Errorf("Price: market %s is not a stonk market", m.ID)
+	}
+
+	return StonkPrice(*m.Probability), nil
+}
+
+// CreateStonk creates a stonk market. Stonk markets never close or resolve, so they have no close time.
+//
+// Parameters:
+//   - question: The question the market is based on. Required.
+//   - description: A description of the market. Optional.
+//   - visibility: The visibility of the market ("public" or "unlisted"). Optional.
+//   - extraLiquidity: The extra liquidity to add to the market. Optional.
+//
+// Returns:
+//   - *LiteMarket: A pointer to the created market object.
+//   - error: An error object if the request fails or if input validation fails.
+func (s *MarketService) CreateStonk(question string, description *string, visibility *string, extraLiquidity *int) (*LiteMarket, error) {
+	params := map[string]interface{}{
+		"outcomeType": "STONK",
+		"question":    question,
+	}
+
+	if description != nil {
+		params["description"] = *description
+	}
+	if visibility != nil {
+		if err := checkOneOf(*visibility, "public", "unlisted"); err != nil {
+			return nil, fmt.Errorf("Market: CreateStonk: %w", err)
+		}
+		params["visibility"] = *visibility
+	}
+	if extraLiquidity != nil {
+		params["extraLiquidity"] = *extraLiquidity
+	}
+
+	return s.createMarket(params)
+}
@@ -0,0 +1,172 @@
+package manifold
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Subsidy records liquidity added to a market by a Subsidizer.
+type Subsidy struct {
+	ContractID string    // ID of the market
+	Amount     float64   // Amount of liquidity added
+	Volume     float64   // Volume of the market when the subsidy was added
+	Bettors    int       // Number of unique bettors in the market when the subsidy was added
+	Time       time.Time // Time the subsidy was added
+	Txn        *Txn      // Transaction returned by the API
+}
+
+// Subsidizer monitors the open markets of a creator and tops up their liquidity as they gain activity.
+// A market is subsidized whenever its volume or unique bettor count has grown by a set step since its last subsidy,
+// until the total budget is spent.
+type Subsidizer struct {
+	client     *Client
+	creatorID  string
+	amount     float64
+	volumeStep float64
+	bettorStep int
+	budget     float64
+	onSubsidy  func(Subsidy)
+
+	mu        sync.Mutex
+	spent     float64
+	baselines map[string]subsidyBaseline
+	subsidies []Subsidy
+}
+
+// subsidyBaseline is the activity of a market when it was last subsidized, or first seen.
+type subsidyBaseline struct {
+	volume  float64
+	bettors int
+}
+
+// NewSubsidizer creates a new subsidizer. Markets are first seen on the first Check, which sets their baselines.
+//
+// Parameters:
+//   - client: The client used to list markets and add liquidity. Must be authenticated as the creator. Required.
+//   - creatorID: The ID of the creator whose markets are monitored. Required.
+//   - amount: The amount of liquidity added per subsidy. Required.
+//   - volumeStep: The volume growth that triggers a subsidy. 0 disables the trigger.
+//   - bettorStep: The unique bettor growth that triggers a subsidy. 0 disables the trigger.
+//   - budget: The maximum total amount of liquidity to add. Required.
+//   - onSubsidy: Called for every subsidy added. Optional.
+//
+// Returns:
+//   - *Subsidizer: A pointer to the newly created subsidizer.
+func NewSubsidizer(client *Client, creatorID string, amount float64, volumeStep float64, bettorStep int, budget float64, onSubsidy func(Subsidy)) *Subsidizer {
+	return &Subsidizer{
+		client:     client,
+		creatorID:  creatorID,
+		amount:     amount,
+		volumeStep: volumeStep,
+		bettorStep: bettorStep,
+		budget:     budget,
+		onSubsidy:  onSubsidy,
+		baselines:  make(map[string]subsidyBaseline),
+	}
+}
+
+// Spent returns the total amount of liquidity added so far.
+func (s *Subsidizer) Spent() float64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.spent
+}
+
+// Subsidies returns every subsidy added so far, oldest first.
+func (s *Subsidizer) Subsidies() []Subsidy {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	subsidies := make([]Subsidy, len(s.subsidies))
+	copy(subsidies, s.subsidies)
+
+	return subsidies
+}
+
+// Check lists the creator's open markets once and subsidizes those whose activity has grown past a step.
+//
+// Returns:
+//   - error: An error object if a request fails. Remaining markets are still checked after a failed subsidy.
+func (s *Subsidizer) Check() error {
+	limit := 1000
+	markets, err := s.client.Market.Markets(&limit, nil, nil, nil, &s.creatorID, nil)
+	if err != nil {
+		return fmt.Errorf("Subsidizer: Check: %w", err)
+	}
+
+	now := s.client.Now()
+	var firstErr error
+	for _, market := range markets {
+		if market.IsResolved || (market.CloseTime != nil && now.UnixMilli() >= *market.CloseTime) {
+			continue
+		}
+
+		s.mu.Lock()
+		baseline, ok := s.baselines[market.ID]
+		if !ok {
+			s.baselines[market.ID] = subsidyBaseline{market.Volume, market.UniqueBettorCount}
+		}
+		due := ok && s.spent+s.amount <= s.budget &&
+			((s.volumeStep > 0 && market.Volume-baseline.volume >= s.volumeStep) ||
+				(s.bettorStep > 0 && market.UniqueBettorCount-baseline.bettors >= s.bettorStep))
+		s.mu.Unlock()
+
+		if !due {
+			continue
+		}
+
+		txn, err := s.client.Market.AddLiquidity(market.ID, s.amount)
+		if err != nil {
+			if firstErr == nil {
+				firstErr = fmt.Errorf("Subsidizer: Check: %w", err)
+			}
+			continue
+		}
+
+		subsidy := Subsidy{
+			ContractID: market.ID,
+			Amount:     s.amount,
+			Volume:     market.Volume,
+			Bettors:    market.UniqueBettorCount,
+			Time:       now,
+			Txn:        txn,
+		}
+
+		s.mu.Lock()
+		s.spent += s.amount
+		s.baselines[market.ID] = subsidyBaseline{market.Volume, market.UniqueBettorCount}
+		s.subsidies = append(s.subsidies, subsidy)
+		s.mu.Unlock()
+
+		if s.onSubsidy != nil {
+			s.onSubsidy(subsidy)
+		}
+	}
+
+	return firstErr
+}
+
+// Run calls Check at the given interval until stop is closed. Errors from Check are passed to onError.
+//
+// Parameters:
+//   - interval: The time between checks. Required.
+//   - stop: A channel that stops the subsidizer when closed. Required.
+//   - onError: Called with every error returned by Check. Optional.
+func (s *Subsidizer) Run(interval time.Duration, stop <-chan struct{}, onError func(error)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.Check(); err != nil && onError != nil {
+			onError(err)
+		}
+
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,140 @@
+package manifold
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+	"time"
+)
+
+// DefaultSummaryTemplate renders sentences such as
+// "Will X happen? is at 62% (+5pp this week) with Ṁ12,000 volume; closes in 3 days."
+const DefaultSummaryTemplate = `{{.Market.Question}} ` +
+	`{{if .Market.IsResolved}}resolved {{deref .Market.Resolution}}` +
+	`{{else if .Market.Probability}}is at {{prob (deref .Market.Probability)}}{{if .Change}} ({{pp (deref .Change)}} {{.Window}}){{end}}` +
+	`{{else}}has {{.Market.UniqueBettorCount}} traders{{end}} ` +
+	`with {{mana .Market.Volume}} volume` +
+	`{{if and (not .Market.IsResolved) .Market.CloseTime}}; {{closes (deref .Market.CloseTime) .Now}}{{end}}.`
+
+// SummaryData is the data a Summarizer template is rendered with.
+type SummaryData struct {
+	Market *LiteMarket // The market to summarize
+	Change *float64    // Change in probability over the window (optional)
+	Window string      // Description of the window of the change, e.g. "this week"
+	Now    time.Time   // Time the summary is rendered at, for relative times
+}
+
+// Summarizer renders natural language summaries of markets from a text/template. Besides the standard functions,
+// templates can use mana, prob, and pp to format amounts, probabilities, and probability changes, relative and
+// closes to format times, and deref to read optional fields.
+type Summarizer struct {
+	template *template.Template
+}
+
+// summaryFuncs are the functions available to Summarizer templates.
+var summaryFuncs = template.FuncMap{
+	"mana": func(amount float64) string { return FormatMana(amount, 0) },
+	"prob": func(prob float64) string { return FormatProb(prob, 0) },
+	"pp":   func(delta float64) string { return FormatProbChange(delta, 0) },
+	"relative": func(ms int64, now time.Time) string {
+		return FormatRelativeTime(time.UnixMilli(ms), now)
+	},
+	"closes": func(ms int64, now time.Time) string {
+		t := time.UnixMilli(ms)
+		if t.After(now) {
+			return "closes " + FormatRelativeTime(t, now)
+		}
+		return "closed " + FormatRelativeTime(t, now)
+	},
+	"deref": func(v any) any {
+		switch p := v.(type) {
+		case *float64:
+			return *p
+		case *string:
+			return *p
+		case *int64:
+			return *p
+		}
+		return v
+	},
+}
+
+// NewSummarizer creates a new summarizer from a template.
+//
+// Parameters:
+//   - text: The template text, e.g. DefaultSummaryTemplate. Required.
+//
+// Returns:
+//   - *Summarizer: A pointer to the newly created summarizer.
+//   - error: An error object if the template cannot be parsed.
+func NewSummarizer(text string) (*Summarizer, error) {
+	t, err := template.New("summary").Funcs(summaryFuncs).Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("NewSummarizer: %w", err)
+	}
+
+	return &Summarizer{template: t}, nil
+}
+
+// Summarize renders the summary of a market.
+//
+// Parameters:
+//   - data: The market and derived stats to render. Required.
+//
+// Returns:
+//   - string: The rendered summary.
+//   - error: An error object if the template fails to render.
+func (s *Summarizer) Summarize(data SummaryData) (string, error) {
+	if data.Now.IsZero() {
+		data.Now = time.Now()
+	}
+
+	var b strings.Builder
+	if err := s.template.Execute(&b, data); err != nil {
+		return "", fmt.Errorf("Summarizer: Summarize: %w", err)
+	}
+
+	return b.String(), nil
+}
+
+// Summary retrieves a market and the change of its probability over a recent window, and renders its summary.
+//
+// Parameters:
+//   - id: The ID of the market. Required.
+//   - window: The length of the window to compute the change over. Required.
+//   - windowText: The description of the window, e.g. "this week". Required.
+//   - summarizer: The summarizer to render with. Required.
+//
+// Returns:
+//   - string: The rendered summary.
+//   - error: An error object if a request fails or if the template fails to render.
+func (s *MarketService) Summary(id string, window time.Duration, windowText string, summarizer *Summarizer) (string, error) {
+	market, err := s.Market(id)
+	if err != nil {
+		return "", fmt.Errorf("Market: Summary: %w", err)
+	}
+
+	data := SummaryData{Market: &market.LiteMarket, Window: windowText, Now: time.Now()}
+
+	if market.Probability != nil {
+		since := data.Now.Add(-window)
+		limit, order := 1, "asc"
+		bets, err := s.client.Bet.Bets(nil, nil, &id, nil, &limit, nil, nil, nil, &since, nil, &order)
+		if err != nil {
+			return "", fmt.Errorf("Market: Summary: %w", err)
+		}
+
+		change := 0.0
+		if len(bets) > 0 {
+			change = *market.Probability - bets[0].ProbBefore
+		}
+		data.Change = &change
+	}
+
+	summary, err := summarizer.Summarize(data)
+	if err != nil {
+		return "", fmt.Errorf("Market: %w", err)
+	}
+
+	return summary, nil
+}
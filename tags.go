@@ -0,0 +1,153 @@
+package manifold
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// BetTag is local metadata attached to a bet, used to attribute performance to the strategy that placed it.
+// It is never sent to the API.
+type BetTag struct {
+	Strategy string `json:"strategy"`         // Name of the strategy that placed the bet
+	Signal   string `json:"signal,omitempty"` // ID of the signal that triggered the bet (optional)
+	Notes    string `json:"notes,omitempty"`  // Free-form notes (optional)
+}
+
+// BetTagger records the tags of bets placed through it, keyed by bet ID. The tags can be persisted by the caller
+// with Tags and restored with NewBetTagger, and joined back onto fill events and bet histories with Lookup and Split.
+type BetTagger struct {
+	mu   sync.Mutex
+	tags map[string]BetTag
+}
+
+// NewBetTagger creates a new bet tagger.
+//
+// Parameters:
+//   - tags: Tags from a previous run, keyed by bet ID, e.g. as returned by Tags and persisted. Optional.
+//
+// Returns:
+//   - *BetTagger: A pointer to the newly created tagger.
+func NewBetTagger(tags map[string]BetTag) *BetTagger {
+	t := &BetTagger{tags: make(map[string]BetTag, len(tags))}
+	for id, tag := range tags {
+		t.tags[id] = tag
+	}
+
+	return t
+}
+
+// Tag attaches a tag to a bet, replacing any previous tag.
+//
+// Parameters:
+//   - betID: The ID of the bet. Required.
+//   - tag: The tag to attach. Required.
+func (t *BetTagger) Tag(betID string, tag BetTag) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.tags[betID] = tag
+}
+
+// Lookup returns the tag of a bet, e.g. of the order in a FillEvent.
+//
+// Parameters:
+//   - betID: The ID of the bet. Required.
+//
+// Returns:
+//   - BetTag: The tag of the bet.
+//   - bool: Whether the bet is tagged.
+func (t *BetTagger) Lookup(betID string) (BetTag, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	tag, ok := t.tags[betID]
+	return tag, ok
+}
+
+// Tags returns a copy of all tags, keyed by bet ID.
+//
+// Returns:
+//   - map[string]BetTag: The tags, suitable for persisting and passing to NewBetTagger.
+func (t *BetTagger) Tags() map[string]BetTag {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	tags := make(map[string]BetTag, len(t.tags))
+	for id, tag := range t.tags {
+		tags[id] = tag
+	}
+
+	return tags
+}
+
+// Split groups bets by the strategy they are tagged with. Untagged bets are grouped under the empty string.
+//
+// Parameters:
+//   - bets: The bets to group. Required.
+//
+// Returns:
+//   - map[string][]Bet: The bets of each strategy, in their original order.
+func (t *BetTagger) Split(bets []Bet) map[string][]Bet {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	split := make(map[string][]Bet)
+	for _, bet := range bets {
+		strategy := t.tags[bet.ID].Strategy
+		split[strategy] = append(split[strategy], bet)
+	}
+
+	return split
+}
+
+// AttributePnLByStrategy attributes the profit of a user's fills within [start, end) per strategy and market
+// (see AttributePnL).
+//
+// Parameters:
+//   - tagger: The tagger holding the tags of the user's bets. Required.
+//   - bets: The bets placed by a single user. Required.
+//   - marks: The probability to mark positions at, keyed by answer ID for multiple choice markets and by market ID otherwise. Required.
+//   - start: Only count fills from this time onwards. Required.
+//   - end: Only count fills before this time. Required.
+//
+// Returns:
+//   - map[string][]PnLAttribution: The attribution of each strategy, with untagged bets under the empty string.
+//   - error: An error object if the mark of a traded market is missing.
+func AttributePnLByStrategy(tagger *BetTagger, bets []Bet, marks map[string]float64, start time.Time, end time.Time) (map[string][]PnLAttribution, error) {
+	attributions := make(map[string][]PnLAttribution)
+	for strategy, strategyBets := range tagger.Split(bets) {
+		attribution, err := AttributePnL(strategyBets, marks, start, end)
+		if err != nil {
+			return nil, fmt.Errorf("AttributePnLByStrategy(%s): %w", strategy, err)
+		}
+		attributions[strategy] = attribution
+	}
+
+	return attributions, nil
+}
+
+// CreateTagged places a new bet (see Create) and tags it with local metadata.
+//
+// Parameters:
+//   - tagger: The tagger to record the tag in. Required.
+//   - tag: The tag to attach to the bet. Required.
+//   - amount: The amount of the bet. Required.
+//   - contractID: The ID of the contract on which the bet is being placed. Required.
+//   - outcome: The outcome of the bet (e.g., "YES" or "NO"). Optional.
+//   - limitProb: Probability threshold for a limit order. Must be between 0 and 1. Optional.
+//   - expiresAt: Expiration time for a limit order. Only valid if limitProb is set. Optional.
+//
+// Returns:
+//   - *Bet: The created bet object.
+//   - error: An error object if the request fails, input validation fails, or the response cannot be parsed.
+func (s *BetService) CreateTagged(tagger *BetTagger, tag BetTag, amount float64, contractID string, outcome *string, limitProb *float64, expiresAt *time.Time) (*Bet, error) {
+	bet, err := s.Create(amount, contractID, outcome, limitProb, expiresAt, nil)
+	if err != nil {
+		return nil, fmt.Errorf("Bet: CreateTagged: %w", err)
+	}
+
+	tagger.Tag(bet.ID, tag)
+
+	return bet, nil
+}
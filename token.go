@@ -0,0 +1,58 @@
+package manifold
+
+// Tokens that markets trade in and balances are held in. Amounts in different tokens are not interchangeable
+// and must not be summed together.
+const (
+	TokenMana = "MANA" // Play money
+	TokenCash = "CASH" // Prize cash, redeemable for real money
+)
+
+// TokenOf returns the token the market trades in, defaulting to TokenMana for markets that do not specify one.
+func (m *LiteMarket) TokenOf() string {
+	if m.Token == nil || *m.Token == "" {
+		return TokenMana
+	}
+
+	return *m.Token
+}
+
+// Balances returns the balances of the user per token.
+func (u *User) Balances() map[string]float64 {
+	return map[string]float64{
+		TokenMana: u.Balance,
+		TokenCash: u.CashBalance,
+	}
+}
+
+// CashBalanceMana returns the prize cash balance of the user in the fixed-point Mana representation. The amount is
+// in CASH, not mana, so it must not be added to mana amounts. Like BalanceMana, it converts the float64 field (see
+// ManaFromFloat).
+func (u *User) CashBalanceMana() Mana {
+	return ManaFromFloat(u.CashBalance)
+}
+
+// ExposureByToken returns the total current value of the positions in the portfolio per token.
+func (p *Portfolio) ExposureByToken() map[string]float64 {
+	exposure := make(map[string]float64)
+	for _, position := range p.Positions {
+		token := TokenMana
+		if market, ok := p.Markets[position.ContractID]; ok {
+			token = market.TokenOf()
+		}
+		exposure[token] += position.Payout
+	}
+
+	return exposure
+}
+
+// betsInToken returns the bets placed in markets that trade in a token. Bets in unknown markets are dropped.
+func betsInToken(bets []Bet, markets map[string]*FullMarket, token string) []Bet {
+	filtered := make([]Bet, 0, len(bets))
+	for _, bet := range bets {
+		if market, ok := markets[bet.ContractID]; ok && market.TokenOf() == token {
+			filtered = append(filtered, bet)
+		}
+	}
+
+	return filtered
+}
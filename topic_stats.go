@@ -0,0 +1,235 @@
+package manifold
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+// topicWeekLength is the length of an interval of a TopicWeek series.
+const topicWeekLength = 7 * 24 * time.Hour
+
+// TopicWeek is the activity of a topic during a single week.
+type TopicWeek struct {
+	Start   time.Time // Start of the week
+	Markets int       // Number of markets created in the topic
+	Bettors int       // Total unique bettors of the markets created in the week, as of now
+	Volume  float64   // Total volume of the markets created in the week, as of now
+}
+
+// MarketsPerWeek computes the weekly series of markets created in a topic.
+//
+// Parameters:
+//   - markets: The markets of the topic, in any order. Required.
+//   - start: The start of the first week. Required.
+//   - end: The end of the series. Must be after start. Required.
+//
+// Returns:
+//   - []TopicWeek: The activity of every week, in order.
+//   - error: An error object if input validation fails.
+func MarketsPerWeek(markets []LiteMarket, start time.Time, end time.Time) ([]TopicWeek, error) {
+	if !end.After(start) {
+		return nil, fmt.Errorf("MarketsPerWeek: end must be after start")
+	}
+
+	count := int((end.Sub(start) + topicWeekLength - 1) / topicWeekLength)
+	weeks := make([]TopicWeek, count)
+	for i := range weeks {
+		weeks[i].Start = start.Add(time.Duration(i) * topicWeekLength)
+	}
+
+	startMs, endMs := start.UnixMilli(), end.UnixMilli()
+	for _, market := range markets {
+		if market.CreatedTime < startMs || market.CreatedTime >= endMs {
+			continue
+		}
+
+		w := &weeks[(time.Duration(market.CreatedTime-startMs)*time.Millisecond)/topicWeekLength]
+		w.Markets++
+		w.Bettors += market.UniqueBettorCount
+		w.Volume += market.Volume
+	}
+
+	return weeks, nil
+}
+
+// MarketsPerWeek retrieves the markets created in a topic within [start, end) and computes their weekly series
+// (see MarketsPerWeek).
+//
+// Parameters:
+//   - groupID: The ID of the topic. Required.
+//   - start: The start of the first week. Required.
+//   - end: The end of the series. Must be after start. Required.
+//
+// Returns:
+//   - []TopicWeek: The activity of every week, in order.
+//   - error: An error object if a request fails or if input validation fails.
+func (s *GroupService) MarketsPerWeek(groupID string, start time.Time, end time.Time) ([]TopicWeek, error) {
+	var (
+		markets []LiteMarket
+		before  *string
+		limit   = 1000
+	)
+
+	// Markets are returned newest first, so paging stops once it reaches markets created before the start.
+	for {
+		page, err := s.client.Market.Markets(&limit, nil, nil, before, nil, &groupID)
+		if err != nil {
+			return nil, fmt.Errorf("Group: MarketsPerWeek: %w", err)
+		}
+
+		markets = append(markets, page...)
+		if len(page) < limit || page[len(page)-1].CreatedTime < start.UnixMilli() {
+			break
+		}
+
+		before = &page[len(page)-1].ID
+	}
+
+	weeks, err := MarketsPerWeek(markets, start, end)
+	if err != nil {
+		return nil, fmt.Errorf("Group: %w", err)
+	}
+
+	return weeks, nil
+}
+
+// MemberPoint is the number of members of a topic at a point in time.
+type MemberPoint struct {
+	Time    time.Time `json:"time"`    // Time the member count was sampled
+	Members int       `json:"members"` // Number of members
+}
+
+// MemberTracker samples the member counts of topics over time. The API does not expose when members joined or
+// left, so growth can only be measured from samples taken while tracking.
+type MemberTracker struct {
+	client *Client
+
+	mu      sync.Mutex
+	history map[string][]MemberPoint
+}
+
+// NewMemberTracker creates a new member tracker.
+//
+// Parameters:
+//   - client: The client used to retrieve topics. Required.
+//   - history: Samples from a previous run, keyed by topic slug, e.g. as returned by History and persisted. Optional.
+//
+// Returns:
+//   - *MemberTracker: A pointer to the newly created tracker.
+func NewMemberTracker(client *Client, history map[string][]MemberPoint) *MemberTracker {
+	t := &MemberTracker{client: client, history: make(map[string][]MemberPoint, len(history))}
+	for slug, points := range history {
+		t.history[slug] = append([]MemberPoint(nil), points...)
+	}
+
+	return t
+}
+
+// Track starts tracking a topic. Its member count is sampled on the next Check.
+//
+// Parameters:
+//   - slug: The slug of the topic. Required.
+func (t *MemberTracker) Track(slug string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if _, ok := t.history[slug]; !ok {
+		t.history[slug] = nil
+	}
+}
+
+// History returns a copy of the samples of every tracked topic.
+//
+// Returns:
+//   - map[string][]MemberPoint: The samples of each topic, keyed by slug, oldest first.
+func (t *MemberTracker) History() map[string][]MemberPoint {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	history := make(map[string][]MemberPoint, len(t.history))
+	for slug, points := range t.history {
+		history[slug] = append([]MemberPoint(nil), points...)
+	}
+
+	return history
+}
+
+// Growth returns the change in the member count of a topic since a point in time, measured from the latest sample
+// taken at or before it to the latest sample.
+//
+// Parameters:
+//   - slug: The slug of the topic. Required.
+//   - since: The time to measure growth from. Required.
+//
+// Returns:
+//   - int: The change in the member count.
+//   - bool: Whether there are samples covering the period.
+func (t *MemberTracker) Growth(slug string, since time.Time) (int, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	points := t.history[slug]
+	i := sort.Search(len(points), func(i int) bool { return points[i].Time.After(since) })
+	if i == 0 || len(points) == 0 {
+		return 0, false
+	}
+
+	return points[len(points)-1].Members - points[i-1].Members, true
+}
+
+// Check samples the member count of every tracked topic once.
+//
+// Returns:
+//   - error: An error object if a topic could not be retrieved. Remaining topics are still sampled.
+func (t *MemberTracker) Check() error {
+	t.mu.Lock()
+	slugs := make([]string, 0, len(t.history))
+	for slug := range t.history {
+		slugs = append(slugs, slug)
+	}
+	t.mu.Unlock()
+
+	var firstErr error
+	for _, slug := range slugs {
+		group, err := t.client.Group.Group(slug)
+		if err != nil {
+			if firstErr == nil {
+				firstErr = fmt.Errorf("MemberTracker: Check: %w", err)
+			}
+			continue
+		}
+
+		t.mu.Lock()
+		if _, ok := t.history[slug]; ok {
+			t.history[slug] = append(t.history[slug], MemberPoint{Time: time.Now(), Members: group.TotalMembers})
+		}
+		t.mu.Unlock()
+	}
+
+	return firstErr
+}
+
+// Run calls Check at the given interval until stop is closed. Errors from Check are passed to onError.
+//
+// Parameters:
+//   - interval: The time between samples. Required.
+//   - stop: A channel that stops the tracker when closed. Required.
+//   - onError: Called with every error returned by Check. Optional.
+func (t *MemberTracker) Run(interval time.Duration, stop <-chan struct{}, onError func(error)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := t.Check(); err != nil && onError != nil {
+			onError(err)
+		}
+
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,250 @@
+package manifold
+
+import (
+	"fmt"
+	"math"
+	"sync"
+	"time"
+)
+
+// TrailingOrder is a client-managed limit order whose limit probability trails the market probability by a fixed offset.
+// The underlying API order is cancelled and replaced whenever the market moves far enough, carrying over any unfilled amount.
+type TrailingOrder struct {
+	client     *Client
+	contractID string
+	outcome    string
+	offset     float64
+	tolerance  float64
+
+	mu        sync.Mutex
+	remaining float64
+	order     *Bet
+	replacing string
+	done      bool
+}
+
+// NewTrailingOrder creates a new trailing limit order. No order is placed until Update is called.
+//
+// Parameters:
+//   - client: The client used to poll the market and manage the underlying orders. Required.
+//   - contractID: The ID of the binary market to trade in. Required.
+//   - outcome: The outcome to buy ("YES" or "NO"). Required.
+//   - amount: The total amount to buy. Must be greater than zero. Required.
+//   - offset: How far behind the market probability the limit trails. Must be between 0 and 1. Required.
+//   - tolerance: How far the target limit may drift from the resting order before it is replaced. Must be 0 or greater. Required.
+//
+// Returns:
+//   - *TrailingOrder: A pointer to the newly created trailing order.
+//   - error: An error object if input validation fails.
+func NewTrailingOrder(client *Client, contractID string, outcome string, amount float64, offset float64, tolerance float64) (*TrailingOrder, error) {
+	if err := checkOneOf(outcome, "YES", "NO"); err != nil {
+		return nil, fmt.Errorf("TrailingOrder: NewTrailingOrder(outcome): %w", err)
+	}
+
+	if amount <= 0 {
+		return nil, fmt.Errorf("TrailingOrder: NewTrailingOrder(amount): invalid value: %f, value must be >0", amount)
+	}
+
+	if err := checkInRange(offset, 0, 1); err != nil {
+		return nil, fmt.Errorf("TrailingOrder: NewTrailingOrder(offset): %w", err)
+	}
+
+	if tolerance < 0 {
+		return nil, fmt.Errorf("TrailingOrder: NewTrailingOrder(tolerance): invalid value: %f, must be 0 or greater", tolerance)
+	}
+
+	return &TrailingOrder{
+		client:     client,
+		contractID: contractID,
+		outcome:    outcome,
+		offset:     offset,
+		tolerance:  tolerance,
+		remaining:  amount,
+	}, nil
+}
+
+// Order returns the currently resting underlying order, or nil if none has been placed.
+func (o *TrailingOrder) Order() *Bet {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	return o.order
+}
+
+// Remaining returns the amount that has not been filled yet.
+func (o *TrailingOrder) Remaining() float64 {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	return o.remaining
+}
+
+// Done reports whether the order has been completely filled or cancelled.
+func (o *TrailingOrder) Done() bool {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	return o.done
+}
+
+// Update refreshes the state of the underlying order and moves it to trail the current market probability.
+//
+// Returns:
+//   - error: An error object if a request fails or if the response cannot be parsed.
+func (o *TrailingOrder) Update() error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if o.done {
+		return nil
+	}
+
+	if err := o.refresh(); err != nil {
+		return fmt.Errorf("TrailingOrder: Update: %w", err)
+	}
+
+	if o.done {
+		return nil
+	}
+
+	market, err := o.client.Market.Market(o.contractID)
+	if err != nil {
+		return fmt.Errorf("TrailingOrder: Update: %w", err)
+	}
+
+	if market.Probability == nil {
+		return fmt.Errorf("TrailingOrder: Update: market has no probability")
+	}
+
+	// Limit probabilities are always expressed in terms of YES, so a NO order trails above the market.
+	target := *market.Probability - o.offset
+	if o.outcome == "NO" {
+		target = *market.Probability + o.offset
+	}
+	target = math.Round(math.Min(math.Max(target, 0.01), 0.99)*100) / 100
+
+	if o.order != nil {
+		if math.Abs(o.order.LimitProps.LimitProb-target) <= o.tolerance {
+			return nil
+		}
+
+		if err := o.client.Bet.Cancel(o.order.ID); err != nil {
+			return fmt.Errorf("TrailingOrder: Update: %w", err)
+		}
+
+		// Fills may have landed since the last refresh, so the cancelled order is fetched again to carry over only
+		// what is left. Until it shows as cancelled, the replacement waits for a later update.
+		o.replacing = o.order.ID
+		if err := o.refresh(); err != nil {
+			return fmt.Errorf("TrailingOrder: Update: %w", err)
+		}
+		if o.done || o.order != nil {
+			return nil
+		}
+	}
+
+	bet, err := o.client.Bet.Create(o.remaining, o.contractID, &o.outcome, &target, nil, nil)
+	if err != nil {
+		return fmt.Errorf("TrailingOrder: Update: %w", err)
+	}
+
+	o.order = bet
+	o.track(bet)
+
+	return nil
+}
+
+// Cancel cancels the underlying order, if any, and stops the trailing order.
+//
+// Returns:
+//   - error: An error object if the request fails.
+func (o *TrailingOrder) Cancel() error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	o.done = true
+	if o.order == nil {
+		return nil
+	}
+
+	if err := o.client.Bet.Cancel(o.order.ID); err != nil {
+		return fmt.Errorf("TrailingOrder: Cancel: %w", err)
+	}
+	o.order = nil
+
+	return nil
+}
+
+// Run calls Update at the given interval until the order is done or stop is closed. Errors from Update are passed to onError.
+//
+// Parameters:
+//   - interval: The time between updates. Required.
+//   - stop: A channel that stops the order from being updated when closed. The resting order is left in place. Required.
+//   - onError: Called with every error returned by Update. Optional.
+func (o *TrailingOrder) Run(interval time.Duration, stop <-chan struct{}, onError func(error)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for !o.Done() {
+		if err := o.Update(); err != nil && onError != nil {
+			onError(err)
+		}
+
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// refresh fetches the latest state of the resting order. Must be called with the mutex held.
+func (o *TrailingOrder) refresh() error {
+	if o.order == nil {
+		return nil
+	}
+
+	bet, err := o.client.Bet.findBet(o.order.UserID, o.contractID, o.order.ID, o.order.CreatedTime)
+	if err != nil {
+		return err
+	}
+
+	if bet != nil {
+		o.order = bet
+		o.track(bet)
+	}
+
+	return nil
+}
+
+// track updates the remaining amount from the state of an order. Must be called with the mutex held.
+func (o *TrailingOrder) track(bet *Bet) {
+	if bet.LimitProps == nil {
+		o.remaining, o.order, o.done = 0, nil, true
+		return
+	}
+
+	var filled float64
+	for _, fill := range bet.LimitProps.Fills {
+		filled += fill.Amount
+	}
+
+	if bet.LimitProps.IsFilled || bet.LimitProps.OrderAmount-filled <= 0 {
+		o.remaining, o.order, o.done = 0, nil, true
+		return
+	}
+
+	if bet.LimitProps.IsCancelled && bet.ID == o.replacing {
+		// The order was cancelled to be replaced.
+		o.remaining, o.order, o.replacing = bet.LimitProps.OrderAmount-filled, nil, ""
+		return
+	}
+
+	if bet.LimitProps.IsCancelled {
+		// The order was cancelled outside of the trailing order, which stops it.
+		o.remaining, o.order, o.done = bet.LimitProps.OrderAmount-filled, nil, true
+		return
+	}
+
+	o.remaining = bet.LimitProps.OrderAmount - filled
+}
@@ -0,0 +1,79 @@
+package manifold
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestTrailingOrderCarriesOverFillsBeforeReplacing(t *testing.T) {
+	prob := 0.5
+	var bets []Bet
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/market/m1":
+			fmt.Fprintf(w, `{"id":"m1","probability":%f}`, prob)
+		case r.URL.Path == "/bet":
+			var body map[string]string
+			decodeBody(t, r, &body)
+			amount, _ := strconv.ParseFloat(body["amount"], 64)
+			limit, _ := strconv.ParseFloat(body["limitProb"], 64)
+			bet := Bet{ID: fmt.Sprintf("b%d", len(bets)), UserID: "u1", ContractID: "m1", Outcome: "YES", LimitProps: &LimitProps{OrderAmount: amount, LimitProb: limit}}
+			bets = append(bets, bet)
+			json.NewEncoder(w).Encode(bet)
+		case strings.HasPrefix(r.URL.Path, "/bet/cancel/"):
+			// A fill lands after the order was last refreshed, just before it is cancelled.
+			for i := range bets {
+				if bets[i].ID == strings.TrimPrefix(r.URL.Path, "/bet/cancel/") {
+					bets[i].LimitProps.Fills = []Fill{{Amount: 4}}
+					bets[i].LimitProps.IsCancelled = true
+				}
+			}
+			w.Write([]byte(`{}`))
+		case r.URL.Path == "/bets":
+			json.NewEncoder(w).Encode(bets)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("key")
+	client.BaseURL = server.URL
+
+	order, err := NewTrailingOrder(client, "m1", "YES", 10, 0.1, 0)
+	if err != nil {
+		t.Fatalf("NewTrailingOrder() error = %v", err)
+	}
+	if err := order.Update(); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+
+	prob = 0.6
+	if err := order.Update(); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+
+	if len(bets) != 2 {
+		t.Fatalf("placed %d orders, want 2", len(bets))
+	}
+	if got := bets[1].LimitProps.OrderAmount; got != 6 {
+		t.Errorf("replacement amount = %v, want 6", got)
+	}
+	if order.Done() {
+		t.Error("order done after being replaced")
+	}
+}
+
+func TestNewTrailingOrderTolerance(t *testing.T) {
+	if _, err := NewTrailingOrder(nil, "m1", "YES", 10, 0.1, 0); err != nil {
+		t.Errorf("NewTrailingOrder(tolerance 0) error = %v, want nil", err)
+	}
+	if _, err := NewTrailingOrder(nil, "m1", "YES", 10, 0.1, -0.01); err == nil {
+		t.Error("NewTrailingOrder(tolerance -0.01) error = nil, want an error")
+	}
+}
@@ -0,0 +1,140 @@
+package manifold
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// TWAP computes the time-weighted average probability of a market over the interval [start, end) from its bet history.
+// The probability is treated as constant between bets, starting from the last bet placed before start.
+//
+// Parameters:
+//   - bets: The bet history of the market (or of a single answer), in any order. Required.
+//   - start: The start of the averaging interval. Required.
+//   - end: The end of the averaging interval. Must be after start. Required.
+//   - sampling: If greater than zero, the probability is sampled at this interval from start instead of integrated exactly. Must be 0 (exact) or a whole number of milliseconds. Optional.
+//
+// Returns:
+//   - float64: The time-weighted average probability.
+//   - error: An error object if input validation fails or no probability is known within the interval.
+func TWAP(bets []Bet, start time.Time, end time.Time, sampling time.Duration) (float64, error) {
+	if !end.After(start) {
+		return 0, fmt.Errorf("TWAP: end must be after start")
+	}
+
+	// Samples are taken at millisecond timestamps, so the interval must be a whole number of milliseconds.
+	if sampling < 0 || sampling%time.Millisecond != 0 {
+		return 0, fmt.Errorf("TWAP(sampling): invalid value: %v, must be 0 (exact) or a positive whole number of milliseconds", sampling)
+	}
+
+	sorted := make([]Bet, len(bets))
+	copy(sorted, bets)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].CreatedTime < sorted[j].CreatedTime
+	})
+
+	// probAt returns the probability in effect at t, or false if no bet was placed before t.
+	probAt := func(t int64) (float64, bool) {
+		i := sort.Search(len(sorted), func(i int) bool {
+			return sorted[i].CreatedTime > t
+		})
+		if i == 0 {
+			return 0, false
+		}
+
+		return sorted[i-1].ProbAfter, true
+	}
+
+	startMs, endMs := start.UnixMilli(), end.UnixMilli()
+
+	if sampling > 0 {
+		var (
+			total   float64
+			samples int
+		)
+		for t := startMs; t < endMs; t += sampling.Milliseconds() {
+			if prob, ok := probAt(t); ok {
+				total += prob
+				samples++
+			}
+		}
+
+		if samples == 0 {
+			return 0, fmt.Errorf("TWAP: no bets placed before the end of the interval")
+		}
+
+		return total / float64(samples), nil
+	}
+
+	var (
+		total    float64
+		duration int64
+		cursor   = startMs
+	)
+
+	prob, ok := probAt(startMs)
+	for _, bet := range sorted {
+		if bet.CreatedTime <= startMs {
+			continue
+		}
+
+		if bet.CreatedTime >= endMs {
+			break
+		}
+
+		if ok {
+			total += prob * float64(bet.CreatedTime-cursor)
+			duration += bet.CreatedTime - cursor
+		}
+
+		cursor, prob, ok = bet.CreatedTime, bet.ProbAfter, true
+	}
+
+	if ok {
+		total += prob * float64(endMs-cursor)
+		duration += endMs - cursor
+	}
+
+	if duration == 0 {
+		return 0, fmt.Errorf("TWAP: no bets placed before the end of the interval")
+	}
+
+	return total / float64(duration), nil
+}
+
+// TWAP fetches the bet history of a market and computes its time-weighted average probability over [start, end).
+//
+// Parameters:
+//   - id: The ID of the market. Required.
+//   - answerID: Only consider bets on this answer, for multiple choice markets. Optional.
+//   - start: The start of the averaging interval. Required.
+//   - end: The end of the averaging interval. Must be after start. Required.
+//   - sampling: If greater than zero, the probability is sampled at this interval instead of integrated exactly. Must be 0 (exact) or a whole number of milliseconds. Optional.
+//
+// Returns:
+//   - float64: The time-weighted average probability.
+//   - error: An error object if the request fails or if input validation fails.
+func (s *MarketService) TWAP(id string, answerID *string, start time.Time, end time.Time, sampling time.Duration) (float64, error) {
+	bets, err := s.client.Bet.allBets(nil, &id, nil)
+	if err != nil {
+		return 0, fmt.Errorf("Market: TWAP: %w", err)
+	}
+
+	if answerID != nil {
+		filtered := make([]Bet, 0, len(bets))
+		for _, bet := range bets {
+			if bet.AnswerID != nil && *bet.AnswerID == *answerID {
+				filtered = append(filtered, bet)
+			}
+		}
+		bets = filtered
+	}
+
+	twap, err := TWAP(bets, start, end, sampling)
+	if err != nil {
+		return 0, fmt.Errorf("Market: %w", err)
+	}
+
+	return twap, nil
+}
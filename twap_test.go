@@ -0,0 +1,48 @@
+package manifold
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestTWAP(t *testing.T) {
+	start := time.UnixMilli(10_000)
+	end := time.UnixMilli(20_000)
+	bets := []Bet{
+		{CreatedTime: 15_000, ProbAfter: 0.8},
+		{CreatedTime: 5_000, ProbAfter: 0.4},
+		{CreatedTime: 25_000, ProbAfter: 0.1},
+	}
+
+	tests := []struct {
+		name     string
+		bets     []Bet
+		start    time.Time
+		end      time.Time
+		sampling time.Duration
+		want     float64
+		wantErr  bool
+	}{
+		{name: "exact", bets: bets, start: start, end: end, want: 0.6},
+		{name: "sampled", bets: bets, start: start, end: end, sampling: 2500 * time.Millisecond, want: 0.6},
+		{name: "starts at first bet", bets: bets, start: time.UnixMilli(0), end: end, want: (0.4*10 + 0.8*5) / 15},
+		{name: "no bets before end", bets: bets[2:], start: start, end: end, wantErr: true},
+		{name: "end before start", bets: bets, start: end, end: start, wantErr: true},
+		{name: "negative sampling", bets: bets, start: start, end: end, sampling: -time.Second, wantErr: true},
+		{name: "sub-millisecond sampling", bets: bets, start: start, end: end, sampling: time.Microsecond, wantErr: true},
+		{name: "fractional millisecond sampling", bets: bets, start: start, end: end, sampling: 1500 * time.Microsecond, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := TWAP(tt.bets, tt.start, tt.end, tt.sampling)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("TWAP() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("TWAP() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package manifold
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// TxnCategory is the category of a transaction.
+type TxnCategory string
+
+const (
+	TxnBettingStreakBonus TxnCategory = "BETTING_STREAK_BONUS" // Bonus for keeping a daily betting streak
+	TxnUniqueBettorBonus  TxnCategory = "UNIQUE_BETTOR_BONUS"  // Bonus paid to a creator when a new user bets on their market
+	TxnMarketBoost        TxnCategory = "MARKET_BOOST"         // Payment to boost a market in the feed
+	TxnLoan               TxnCategory = "LOAN"                 // Daily loan against open positions, which has no typed data (see below)
+	TxnManaPayment        TxnCategory = "MANA_PAYMENT"         // Managram sent between users
+	TxnAddSubsidy         TxnCategory = "ADD_SUBSIDY"          // Liquidity added to a market
+	TxnBountyAdded        TxnCategory = "BOUNTY_ADDED"         // Bounty added to a market
+	TxnBountyAwarded      TxnCategory = "BOUNTY_AWARDED"       // Bounty awarded to a comment
+)
+
+// Loans have no data type or accessor: a TxnLoan is paid from the bank to a user against all of their positions at
+// once, so its amount and recipient, already on Txn, are all there is to it. Any data the API adds can still be read
+// with DecodeTxnData.
+
+// BettingStreakBonusData is the data of a TxnBettingStreakBonus transaction.
+type BettingStreakBonusData struct {
+	CurrentBettingStreak int     `json:"currentBettingStreak"` // Length of the streak the bonus was paid for
+	ContractID           *string `json:"contractId,omitempty"` // ID of the market whose bet extended the streak (optional)
+}
+
+// UniqueBettorBonusData is the data of a TxnUniqueBettorBonus transaction.
+type UniqueBettorBonusData struct {
+	ContractID        string  `json:"contractId"`                  // ID of the market the new bettor bet on
+	UniqueNewBettorID *string `json:"uniqueNewBettorId,omitempty"` // ID of the new bettor (optional)
+	AnswerID          *string `json:"answerId,omitempty"`          // ID of the answer bet on, for multiple choice markets (optional)
+	IsPartner         *bool   `json:"isPartner,omitempty"`         // Whether the creator is in the partner program (optional)
+}
+
+// MarketBoostData is the data of a TxnMarketBoost transaction.
+type MarketBoostData struct {
+	ContractID string  `json:"contractId"`        // ID of the boosted market
+	BoostID    *string `json:"boostId,omitempty"` // ID of the boost (optional)
+}
+
+// TxnKind returns the category of the transaction.
+//
+// Returns:
+//   - TxnCategory: The category of the transaction.
+func (t *Txn) TxnKind() TxnCategory {
+	return TxnCategory(t.Category)
+}
+
+// DecodeTxnData decodes the extra data of a transaction into a typed struct, such as UniqueBettorBonusData.
+//
+// Parameters:
+//   - txn: The transaction. Required.
+//   - data: A pointer to the struct to decode into. Required.
+//
+// Returns:
+//   - error: An error object if the data cannot be decoded.
+func DecodeTxnData(txn *Txn, data interface{}) error {
+	raw, err := json.Marshal(txn.Data)
+	if err != nil {
+		return fmt.Errorf("DecodeTxnData: %w: %w", ErrorFailedToParseResponse, err)
+	}
+
+	if err := json.Unmarshal(raw, data); err != nil {
+		return fmt.Errorf("DecodeTxnData: %w: %w", ErrorFailedToParseResponse, err)
+	}
+
+	return nil
+}
+
+// decodeTxnCategory decodes the data of a transaction after checking its category.
+func decodeTxnCategory[T any](txn *Txn, category TxnCategory) (*T, error) {
+	if txn.TxnKind() != category {
+		return nil, fmt.Errorf("transaction %s is %s, not %s", txn.ID, txn.Category, category)
+	}
+
+	data := new(T)
+	if err := DecodeTxnData(txn, data); err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+// BettingStreakBonus returns the data of a TxnBettingStreakBonus transaction.
+//
+// Returns:
+//   - *BettingStreakBonusData: A pointer to the data of the transaction.
+//   - error: An error object if the transaction is of another category or its data cannot be decoded.
+func (t *Txn) BettingStreakBonus() (*BettingStreakBonusData, error) {
+	return decodeTxnCategory[BettingStreakBonusData](t, TxnBettingStreakBonus)
+}
+
+// UniqueBettorBonus returns the data of a TxnUniqueBettorBonus transaction.
+//
+// Returns:
+//   - *UniqueBettorBonusData: A pointer to the data of the transaction.
+//   - error: An error object if the transaction is of another category or its data cannot be decoded.
+func (t *Txn) UniqueBettorBonus() (*UniqueBettorBonusData, error) {
+	return decodeTxnCategory[UniqueBettorBonusData](t, TxnUniqueBettorBonus)
+}
+
+// MarketBoost returns the data of a TxnMarketBoost transaction.
+//
+// Returns:
+//   - *MarketBoostData: A pointer to the data of the transaction.
+//   - error: An error object if the transaction is of another category or its data cannot be decoded.
+func (t *Txn) MarketBoost() (*MarketBoostData, error) {
+	return decodeTxnCategory[MarketBoostData](t, TxnMarketBoost)
+}
@@ -30,8 +30,11 @@ type User struct {
 	IsTrustworthy        *bool        `json:"isTrustworthy,omitempty"`        // Indicates if the user is trustworthy (optional)
 	IsBannedFromPosting  *bool        `json:"isBannedFromPosting,omitempty"`  // Indicates if the user is banned from posting (optional)
 	UserDeleted          *bool        `json:"userDeleted,omitempty"`          // Indicates if the user has been deleted (optional)
-	Balance              float64      `json:"balance"`                        // Current balance of the user
-	TotalDeposits        float64      `json:"totalDeposits"`                  // Total deposits made by the user
+	Balance              float64      `json:"balance"`                        // Current mana balance of the user
+	CashBalance          float64      `json:"cashBalance"`                    // Current prize cash balance of the user
+	SpiceBalance         float64      `json:"spiceBalance"`                   // Current spice (prize point) balance of the user
+	TotalDeposits        float64      `json:"totalDeposits"`                  // Total mana deposits made by the user
+	TotalCashDeposits    float64      `json:"totalCashDeposits"`              // Total prize cash deposits made by the user
 	LastBetTime          *int64       `json:"lastBetTime,omitempty"`          // Timestamp of the user's last bet (optional)
 	CurrentBettingStreak *int         `json:"currentBettingStreak,omitempty"` // User's current betting streak (optional)
 	ProfitCached         ProfitCached `json:"profitCached"`                   // Cached profit data for the user
@@ -78,19 +81,25 @@ type Trader struct {
 type FullMarket struct {
 	LiteMarket
 
-	Answers               *[]ApiAnswer `json:"answers,omitempty"`               // List of possible answers (optional)
-	ShouldAnswersSumToOne *bool        `json:"shouldAnswersSumToOne,omitempty"` // Indicates if answers should sum to one (optional)
-	AddAnswersMode        *string      `json:"addAnswersMode,omitempty"`        // Mode for adding answers ("ANYONE", "ONLY_CREATOR", "DISABLED") (optional)
-	Options               *[]struct {
-		Text  string `json:"text"`  // Text of the option
-		Votes int    `json:"votes"` // Number of votes for the option
-	} `json:"options,omitempty"` // List of options and their votes (optional)
-	TotalBounty     *float64        `json:"totalBounty,omitempty"`   // Total bounty for the market (optional)
-	BountyLeft      *float64        `json:"bountyLeft,omitempty"`    // Bounty left for the market (optional)
-	Description     json.RawMessage `json:"description"`             // Detailed description of the market
-	TextDescription string          `json:"textDescription"`         // Text-based description of the market
-	CoverImageUrl   *string         `json:"coverImageUrl,omitempty"` // URL to the market's cover image (optional)
-	GroupSlugs      *[]string       `json:"groupSlugs,omitempty"`    // List of group slugs associated with the market (optional)
+	Answers               *[]ApiAnswer    `json:"answers,omitempty"`               // List of possible answers (optional)
+	ShouldAnswersSumToOne *bool           `json:"shouldAnswersSumToOne,omitempty"` // Indicates if answers should sum to one (optional)
+	AddAnswersMode        *string         `json:"addAnswersMode,omitempty"`        // Mode for adding answers ("ANYONE", "ONLY_CREATOR", "DISABLED") (optional)
+	Options               *[]PollOption   `json:"options,omitempty"`               // List of options and their votes (optional)
+	TotalBounty           *float64        `json:"totalBounty,omitempty"`           // Total bounty for the market (optional)
+	BountyLeft            *float64        `json:"bountyLeft,omitempty"`            // Bounty left for the market (optional)
+	Description           json.RawMessage `json:"description"`                     // Detailed description of the market
+	TextDescription       string          `json:"textDescription"`                 // Text-based description of the market
+	CoverImageUrl         *string         `json:"coverImageUrl,omitempty"`         // URL to the market's cover image (optional)
+	GroupSlugs            *[]string       `json:"groupSlugs,omitempty"`            // List of group slugs associated with the market (optional)
+	Visibility            *string         `json:"visibility,omitempty"`            // Visibility of the market ("public", "unlisted") (optional)
+}
+
+// PollOption represents an option of a poll and its votes.
+type PollOption struct {
+	ID    string `json:"id,omitempty"` // Unique identifier for the option
+	Index int    `json:"index"`        // Index of the option in the list
+	Text  string `json:"text"`         // Text of the option
+	Votes int    `json:"votes"`        // Number of votes for the option
 }
 
 // LiteMarket represents a basic view of a market with essential fields.
@@ -124,6 +133,7 @@ type LiteMarket struct {
 	LastUpdatedTime       *int64             `json:"lastUpdatedTime,omitempty"`       // Timestamp when the market was last updated (optional)
 	LastBetTime           *int64             `json:"lastBetTime,omitempty"`           // Timestamp of the last bet (optional)
 	MarketTier            *string            `json:"marketTier,omitempty"`            // Tier of the market (optional)
+	Token                 *string            `json:"token,omitempty"`                 // Token the market trades in ("MANA" or "CASH"), MANA if unset (optional)
 }
 
 // Answer represents a possible answer in a market.
@@ -277,6 +287,7 @@ type Resolution struct {
 // Comments may include replies, visibility settings, and status indicators (e.g., pinned, hidden).
 type Comment struct {
 	ID               string          `json:"id"`                         // Unique identifier for the comment
+	ContractID       string          `json:"contractId"`                 // ID of the market the comment was posted on
 	ReplyToCommentID *string         `json:"replyToCommentId,omitempty"` // Optional ID of the comment being replied to
 	UserID           string          `json:"userId"`                     // ID of the user who made the comment
 	Text             *string         `json:"text,omitempty"`             // Deprecated: Use Content instead
@@ -295,4 +306,5 @@ type Comment struct {
 	Visibility       string          `json:"visibility"`                 // Visibility status of the comment (e.g., "public", "private")
 	EditedTime       *int64          `json:"editedTime,omitempty"`       // Optional timestamp when the comment was last edited
 	IsApi            *bool           `json:"isApi,omitempty"`            // Optional flag indicating if the comment was posted via API
+	BountyAwarded    *float64        `json:"bountyAwarded,omitempty"`    // Optional amount of bounty awarded to the comment
 }
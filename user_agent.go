@@ -0,0 +1,60 @@
+package manifold
+
+import (
+	"net/http"
+)
+
+// Version is the version of this library, sent as part of DefaultUserAgent.
+const Version = "0.1.0"
+
+// DefaultUserAgent is the User-Agent sent with every request unless Client.UserAgent is changed.
+const DefaultUserAgent = "manifold-go/" + Version + " (+https://github.com/e74000/manifold)"
+
+// WithUserAgent identifies a bot to Manifold by prepending its name to the User-Agent, e.g. "mybot/1.2
+// (contact@example.com)", as Manifold asks bot authors to do. To replace the User-Agent entirely, set
+// Client.UserAgent instead.
+//
+// Parameters:
+//   - product: The name, version and contact details of the bot. Required.
+//
+// Returns:
+//   - Option: An option to pass to NewClient.
+func WithUserAgent(product string) Option {
+	return func(c *Client) {
+		c.UserAgent = product + " " + c.UserAgent
+	}
+}
+
+// WithHeader adds a header sent with every request. It can be passed several times, including for the same key.
+//
+// Parameters:
+//   - key: The name of the header. Required.
+//   - value: The value of the header. Required.
+//
+// Returns:
+//   - Option: An option to pass to NewClient.
+func WithHeader(key string, value string) Option {
+	return func(c *Client) {
+		if c.Headers == nil {
+			c.Headers = make(http.Header)
+		}
+		c.Headers.Add(key, value)
+	}
+}
+
+// setDefaultHeaders sets the User-Agent and the custom headers of the client on a request. Headers the request
+// already has, such as Authorization and Content-Type, are left as they are.
+func (c *Client) setDefaultHeaders(req *http.Request) {
+	if c.UserAgent != "" && req.Header.Get("User-Agent") == "" {
+		req.Header.Set("User-Agent", c.UserAgent)
+	}
+
+	for key, values := range c.Headers {
+		if req.Header.Get(key) != "" {
+			continue
+		}
+		for _, value := range values {
+			req.Header.Add(key, value)
+		}
+	}
+}
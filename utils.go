@@ -34,3 +34,26 @@ func checkInRange[T cmp.Ordered](value, min, max T) error {
 	}
 	return nil
 }
+
+// ptr returns a pointer to a copy of the given value, used to fill optional parameters.
+func ptr[T any](v T) *T {
+	return &v
+}
+
+// equalPtr reports whether two optional values are both unset or both set to equal values.
+func equalPtr[T comparable](a, b *T) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return *a == *b
+}
+
+// valueOr returns the value of an optional parameter, or a default if it is unset.
+func valueOr[T any](v *T, def T) T {
+	if v == nil {
+		return def
+	}
+
+	return *v
+}
@@ -0,0 +1,357 @@
+package manifold
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+)
+
+// webhookDeliveries is the number of recent deliveries a WebhookHandler remembers to skip redelivered actions.
+const webhookDeliveries = 1000
+
+// defaultWebhookMaxAge is how old a signed webhook timestamp may be before the delivery is rejected as stale.
+const defaultWebhookMaxAge = 5 * time.Minute
+
+// WebhookAction is the Manifold action a WebhookRule performs.
+type WebhookAction string
+
+const (
+	WebhookComment WebhookAction = "comment" // Post a Markdown comment on a market
+	WebhookBet     WebhookAction = "bet"     // Place a market order on a market
+	WebhookCreate  WebhookAction = "create"  // Create a binary market
+)
+
+// WebhookRule maps incoming webhooks to a Manifold action. Rules are plain data, so they can be loaded from JSON.
+type WebhookRule struct {
+	Name        string            `json:"name"`                  // Name of the rule, reported in results
+	Path        string            `json:"path"`                  // URL path the rule applies to (e.g., "/github")
+	Match       map[string]string `json:"match,omitempty"`       // Dotted JSON paths in the payload and the values they must have (optional)
+	Action      WebhookAction     `json:"action"`                // Action to perform
+	ContractID  string            `json:"contractId,omitempty"`  // Market to comment or bet on
+	Outcome     string            `json:"outcome,omitempty"`     // Outcome to buy ("YES" or "NO"), for bets
+	Amount      float64           `json:"amount,omitempty"`      // Amount to bet, for bets
+	Template    string            `json:"template,omitempty"`    // text/template over the payload, rendering the comment or market question
+	InitialProb int               `json:"initialProb,omitempty"` // Initial probability (between 1 and 99), for created markets
+
+	template *template.Template
+}
+
+// WebhookResult reports the outcome of a rule triggered by an incoming webhook.
+type WebhookResult struct {
+	Rule   string        // Name of the rule
+	Action WebhookAction // Action of the rule
+	Text   string        // Rendered comment or question, if any
+	Market *LiteMarket   // Created market, for create actions
+	Bet    *Bet          // Placed bet, for bet actions
+	Err    error         // Error performing the action, if any
+}
+
+// WebhookHandler is an http.Handler that receives webhooks from external services, such as release notifications or
+// monitoring alerts, and performs Manifold actions according to declarative rules.
+type WebhookHandler struct {
+	mu             sync.Mutex
+	client         *Client
+	rules          []WebhookRule
+	secret         string
+	onResult       func(WebhookResult)
+	maxAge         time.Duration
+	deliveryHeader string
+	delivered      map[string]map[int]bool // Rules performed or in progress, by delivery ID
+	deliveries     []string                // Remembered delivery IDs, oldest first
+}
+
+// NewWebhookHandler creates a new webhook handler.
+//
+// Requests are authenticated with the shared secret in one of three ways, from strongest to weakest:
+//   - An X-Webhook-Signature header holding "sha256=" and the hex HMAC of the X-Webhook-Timestamp header (Unix
+//     seconds), a ".", and the body. Deliveries whose timestamp is further than the maximum age from now are
+//     rejected, so a captured request can only be replayed within that window.
+//   - A GitHub-style X-Hub-Signature-256 HMAC of the body. The body cannot be altered, but a captured request can be
+//     replayed at any time.
+//   - An X-Webhook-Secret header equal to the secret, which binds nothing to the request.
+//
+// Deduplicating by delivery ID is not a replay defence: the delivery header is not covered by any signature, so a
+// replayed request with a new delivery ID performs its actions again. Senders that can should sign a timestamp.
+//
+// Parameters:
+//   - client: The client used to perform actions. Required.
+//   - rules: The rules to apply to incoming webhooks. Required.
+//   - secret: The shared secret that requests must be authenticated with. Required.
+//   - onResult: Called with the result of every triggered rule. Optional.
+//
+// Returns:
+//   - *WebhookHandler: A pointer to the newly created handler.
+//   - error: An error object if a rule is invalid.
+func NewWebhookHandler(client *Client, rules []WebhookRule, secret string, onResult func(WebhookResult)) (*WebhookHandler, error) {
+	if secret == "" {
+		return nil, fmt.Errorf("NewWebhookHandler(secret): must not be empty")
+	}
+
+	parsed := make([]WebhookRule, len(rules))
+	for i, rule := range rules {
+		if err := checkOneOf(rule.Action, WebhookComment, WebhookBet, WebhookCreate); err != nil {
+			return nil, fmt.Errorf("NewWebhookHandler(rules): rule %q: %w", rule.Name, err)
+		}
+
+		if rule.Action == WebhookBet {
+			if err := checkOneOf(rule.Outcome, "YES", "NO"); err != nil {
+				return nil, fmt.Errorf("NewWebhookHandler(rules): rule %q: %w", rule.Name, err)
+			}
+		} else {
+			t, err := template.New(rule.Name).Option("missingkey=zero").Parse(rule.Template)
+			if err != nil {
+				return nil, fmt.Errorf("NewWebhookHandler(rules): rule %q: %w", rule.Name, err)
+			}
+			rule.template = t
+		}
+
+		parsed[i] = rule
+	}
+
+	return &WebhookHandler{
+		client:         client,
+		rules:          parsed,
+		secret:         secret,
+		onResult:       onResult,
+		maxAge:         defaultWebhookMaxAge,
+		deliveryHeader: "X-GitHub-Delivery",
+		delivered:      make(map[string]map[int]bool),
+	}, nil
+}
+
+// SetDeliveryHeader sets the header carrying the unique ID of a delivery, which senders keep when they retry it.
+// Defaults to X-GitHub-Delivery.
+//
+// Parameters:
+//   - header: The name of the header. If empty, deliveries are not identified.
+func (h *WebhookHandler) SetDeliveryHeader(header string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.deliveryHeader = header
+}
+
+// SetMaxAge sets how far the signed timestamp of a delivery may be from now before it is rejected as stale. Defaults
+// to 5 minutes.
+//
+// Parameters:
+//   - maxAge: The maximum age of a signed timestamp. Must be positive.
+func (h *WebhookHandler) SetMaxAge(maxAge time.Duration) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.maxAge = maxAge
+}
+
+// ServeHTTP authenticates an incoming webhook, decodes its JSON payload, and performs the action of every matching
+// rule. It responds 401 for unauthenticated requests, 400 for invalid payloads, and 204 once the actions are performed.
+//
+// If a delivery ID is present and an action fails, it responds 502 so that the sender retries the delivery, and only
+// the actions that have not succeeded yet are performed again. Without a delivery ID, a retry would repeat the actions
+// that succeeded, so failures are only reported through onResult.
+func (h *WebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, 1<<20))
+	if err != nil {
+		http.Error(w, "failed to read body", http.StatusBadRequest)
+		return
+	}
+
+	if !h.authenticated(r, body) {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var payload map[string]any
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	if err := decoder.Decode(&payload); err != nil {
+		http.Error(w, "invalid JSON payload", http.StatusBadRequest)
+		return
+	}
+
+	delivery := h.delivery(r)
+
+	failed := false
+	for i, rule := range h.rules {
+		if rule.Path != r.URL.Path || !webhookMatches(rule.Match, payload) {
+			continue
+		}
+		if !h.claim(delivery, i) {
+			continue
+		}
+
+		result := h.apply(rule, payload)
+		if result.Err != nil {
+			failed = true
+			h.release(delivery, i)
+		}
+
+		if h.onResult != nil {
+			h.onResult(result)
+		}
+	}
+
+	if failed && delivery != "" {
+		http.Error(w, "action failed", http.StatusBadGateway)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// delivery returns the delivery ID of a request, remembering it if it is new.
+func (h *WebhookHandler) delivery(r *http.Request) string {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.deliveryHeader == "" {
+		return ""
+	}
+
+	id := r.Header.Get(h.deliveryHeader)
+	if id == "" || h.delivered[id] != nil {
+		return id
+	}
+
+	if len(h.deliveries) == webhookDeliveries {
+		delete(h.delivered, h.deliveries[0])
+		h.deliveries = h.deliveries[1:]
+	}
+	h.delivered[id] = make(map[int]bool)
+	h.deliveries = append(h.deliveries, id)
+
+	return id
+}
+
+// claim marks a rule as performed for a delivery, reporting false if it already was.
+func (h *WebhookHandler) claim(delivery string, rule int) bool {
+	if delivery == "" {
+		return true
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	// A delivery forgotten since it arrived can no longer be deduplicated.
+	performed := h.delivered[delivery]
+	if performed == nil {
+		return true
+	}
+	if performed[rule] {
+		return false
+	}
+	performed[rule] = true
+
+	return true
+}
+
+// release unmarks a rule whose action failed, so that a retry of the delivery performs it again.
+func (h *WebhookHandler) release(delivery string, rule int) {
+	if delivery == "" {
+		return
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if performed := h.delivered[delivery]; performed != nil {
+		delete(performed, rule)
+	}
+}
+
+// authenticated checks the signature or secret header of a request, rejecting stale timestamped signatures.
+func (h *WebhookHandler) authenticated(r *http.Request, body []byte) bool {
+	if signature := r.Header.Get("X-Webhook-Signature"); signature != "" {
+		timestamp := r.Header.Get("X-Webhook-Timestamp")
+		seconds, err := strconv.ParseInt(timestamp, 10, 64)
+		if err != nil {
+			return false
+		}
+
+		mac := hmac.New(sha256.New, []byte(h.secret))
+		mac.Write([]byte(timestamp + "."))
+		mac.Write(body)
+		expected := "sha256=" + hex.EncodeToString(mac.Sum(nil))
+		if !hmac.Equal([]byte(signature), []byte(expected)) {
+			return false
+		}
+
+		h.mu.Lock()
+		maxAge := h.maxAge
+		h.mu.Unlock()
+
+		age := time.Since(time.Unix(seconds, 0))
+		return age <= maxAge && age >= -maxAge
+	}
+
+	if signature := r.Header.Get("X-Hub-Signature-256"); signature != "" {
+		mac := hmac.New(sha256.New, []byte(h.secret))
+		mac.Write(body)
+		expected := "sha256=" + hex.EncodeToString(mac.Sum(nil))
+
+		return hmac.Equal([]byte(signature), []byte(expected))
+	}
+
+	return subtle.ConstantTimeCompare([]byte(r.Header.Get("X-Webhook-Secret")), []byte(h.secret)) == 1
+}
+
+// apply performs the action of a rule for a payload.
+func (h *WebhookHandler) apply(rule WebhookRule, payload map[string]any) WebhookResult {
+	result := WebhookResult{Rule: rule.Name, Action: rule.Action}
+
+	if rule.template != nil {
+		var b strings.Builder
+		if err := rule.template.Execute(&b, payload); err != nil {
+			result.Err = err
+			return result
+		}
+		result.Text = strings.TrimSpace(b.String())
+	}
+
+	switch rule.Action {
+	case WebhookComment:
+		result.Err = h.client.Comment.CommentMarkdown(rule.ContractID, result.Text)
+	case WebhookBet:
+		result.Bet, result.Err = h.client.Bet.Create(rule.Amount, rule.ContractID, &rule.Outcome, nil, nil, nil)
+	case WebhookCreate:
+		result.Market, result.Err = h.client.Market.CreateBinary(result.Text, rule.InitialProb, nil, nil, nil, nil)
+	}
+
+	return result
+}
+
+// webhookMatches reports whether every dotted path in match has the required value in the payload.
+func webhookMatches(match map[string]string, payload map[string]any) bool {
+	for path, want := range match {
+		var value any = payload
+		for _, key := range strings.Split(path, ".") {
+			object, ok := value.(map[string]any)
+			if !ok {
+				return false
+			}
+			value = object[key]
+		}
+
+		if value == nil || fmt.Sprint(value) != want {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,124 @@
+package manifold
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWebhookHandlerRedelivery(t *testing.T) {
+	comments, bets := 0, 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/comment":
+			comments++
+			w.Write([]byte(`{}`))
+		case "/bet":
+			bets++
+			if bets == 1 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				w.Write([]byte(`{"message":"unavailable"}`))
+				return
+			}
+			w.Write([]byte(`{"id":"b"}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("key")
+	client.BaseURL = server.URL
+	client.RateLimitRetries = 0
+
+	rules := []WebhookRule{
+		{Name: "comment", Path: "/hook", Action: WebhookComment, ContractID: "m", Template: "Released {{.tag}}"},
+		{Name: "bet", Path: "/hook", Action: WebhookBet, ContractID: "m", Outcome: "YES", Amount: 10},
+	}
+	handler, err := NewWebhookHandler(client, rules, "secret", nil)
+	if err != nil {
+		t.Fatalf("NewWebhookHandler() error = %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		delivery string
+		status   int
+		comments int
+		bets     int
+	}{
+		{"bet fails", "d1", http.StatusBadGateway, 1, 1},
+		{"retry only repeats the failed bet", "d1", http.StatusNoContent, 1, 2},
+		{"retry after success does nothing", "d1", http.StatusNoContent, 1, 2},
+		{"new delivery", "d2", http.StatusNoContent, 2, 3},
+	}
+
+	for _, tt := range tests {
+		req := httptest.NewRequest(http.MethodPost, "/hook", strings.NewReader(`{"tag":"v1"}`))
+		req.Header.Set("X-Webhook-Secret", "secret")
+		req.Header.Set("X-GitHub-Delivery", tt.delivery)
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		if rec.Code != tt.status || comments != tt.comments || bets != tt.bets {
+			t.Errorf("%s: status %d, %d comments, %d bets; want %d, %d, %d",
+				tt.name, rec.Code, comments, bets, tt.status, tt.comments, tt.bets)
+		}
+	}
+}
+
+func TestWebhookHandlerSignedTimestamp(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("key")
+	client.BaseURL = server.URL
+
+	rules := []WebhookRule{{Name: "comment", Path: "/hook", Action: WebhookComment, ContractID: "m", Template: "hi"}}
+	handler, err := NewWebhookHandler(client, rules, "secret", nil)
+	if err != nil {
+		t.Fatalf("NewWebhookHandler() error = %v", err)
+	}
+
+	body := `{"tag":"v1"}`
+	sign := func(secret, timestamp, body string) string {
+		mac := hmac.New(sha256.New, []byte(secret))
+		mac.Write([]byte(timestamp + "." + body))
+		return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+	}
+	now := strconv.FormatInt(time.Now().Unix(), 10)
+	stale := strconv.FormatInt(time.Now().Add(-time.Hour).Unix(), 10)
+
+	tests := []struct {
+		name      string
+		timestamp string
+		signature string
+		status    int
+	}{
+		{"fresh", now, sign("secret", now, body), http.StatusNoContent},
+		{"stale", stale, sign("secret", stale, body), http.StatusUnauthorized},
+		{"timestamp altered", now, sign("secret", stale, body), http.StatusUnauthorized},
+		{"wrong secret", now, sign("other", now, body), http.StatusUnauthorized},
+		{"missing timestamp", "", sign("secret", "", body), http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		req := httptest.NewRequest(http.MethodPost, "/hook", strings.NewReader(body))
+		req.Header.Set("X-Webhook-Timestamp", tt.timestamp)
+		req.Header.Set("X-Webhook-Signature", tt.signature)
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		if rec.Code != tt.status {
+			t.Errorf("%s: status %d, want %d", tt.name, rec.Code, tt.status)
+		}
+	}
+}
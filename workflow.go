@@ -0,0 +1,112 @@
+package manifold
+
+import (
+	"fmt"
+	"time"
+)
+
+// WorkflowStep is a single step of a multi-step Workflow.
+//
+// A failed step is retried up to Retries times, waiting Backoff before the first retry and twice as long before each
+// following one. Steps that are retried should be idempotent, or check the state for the effects of earlier attempts.
+type WorkflowStep struct {
+	Name    string                           // Unique name of the step, used to record its completion
+	Run     func(state *WorkflowState) error // Performs the step, reading and storing values in the state
+	Retries int                              // Number of times the step is retried after failing (optional)
+	Backoff time.Duration                    // Wait before the first retry, doubled before each following one (optional)
+}
+
+// WorkflowState records the progress of a Workflow. It is serializable to JSON,
+// so it can be persisted by the caller and used to resume the workflow after a crash.
+type WorkflowState struct {
+	Completed []string          `json:"completed"` // Names of the steps completed so far, in order
+	Values    map[string]string `json:"values"`    // Values produced by completed steps, such as created IDs
+}
+
+// Done reports whether the named step has been completed.
+func (s *WorkflowState) Done(step string) bool {
+	for _, name := range s.Completed {
+		if name == step {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Set stores a value produced by a step.
+func (s *WorkflowState) Set(key string, value string) {
+	if s.Values == nil {
+		s.Values = make(map[string]string)
+	}
+
+	s.Values[key] = value
+}
+
+// Get retrieves a value produced by a previous step.
+func (s *WorkflowState) Get(key string) (string, bool) {
+	value, ok := s.Values[key]
+	return value, ok
+}
+
+// Workflow runs a sequence of steps, checkpointing after each one so that a failed or interrupted
+// run can be resumed from the first incomplete step instead of repeating completed ones.
+type Workflow struct {
+	Steps        []WorkflowStep            // Steps to run, in order
+	OnCheckpoint func(WorkflowState) error // Called with the state after every completed step, to persist it (optional)
+}
+
+// Run runs every step that has not been completed yet in the given state.
+//
+// Parameters:
+//   - state: The state to resume from. A zero value starts the workflow from the beginning. Required.
+//
+// Returns:
+//   - error: An error object if a step fails after its retries, or a checkpoint fails. The state reflects every step completed before the failure.
+func (w *Workflow) Run(state *WorkflowState) error {
+	seen := make(map[string]bool, len(w.Steps))
+	for _, step := range w.Steps {
+		if seen[step.Name] {
+			return fmt.Errorf("Workflow: Run: duplicate step %q", step.Name)
+		}
+		seen[step.Name] = true
+
+		if step.Retries < 0 || step.Backoff < 0 {
+			return fmt.Errorf("Workflow: Run(%s): retries and backoff must not be negative", step.Name)
+		}
+	}
+
+	for _, step := range w.Steps {
+		if state.Done(step.Name) {
+			continue
+		}
+
+		if err := step.run(state); err != nil {
+			return fmt.Errorf("Workflow: Run(%s): %w", step.Name, err)
+		}
+
+		state.Completed = append(state.Completed, step.Name)
+
+		if w.OnCheckpoint != nil {
+			if err := w.OnCheckpoint(*state); err != nil {
+				return fmt.Errorf("Workflow: Run(%s): %w: %w", step.Name, ErrorCheckpointFailed, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// run performs the step, retrying it with exponential backoff until it succeeds or runs out of retries.
+func (s WorkflowStep) run(state *WorkflowState) error {
+	wait := s.Backoff
+	for attempt := 0; ; attempt++ {
+		err := s.Run(state)
+		if err == nil || attempt >= s.Retries {
+			return err
+		}
+
+		time.Sleep(wait)
+		wait *= 2
+	}
+}
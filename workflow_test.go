@@ -0,0 +1,59 @@
+package manifold
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestWorkflowStepRetries(t *testing.T) {
+	tests := []struct {
+		name     string
+		retries  int
+		failures int
+		wantErr  bool
+		attempts int
+	}{
+		{name: "succeeds first time", retries: 2, failures: 0, attempts: 1},
+		{name: "succeeds on retry", retries: 2, failures: 2, attempts: 3},
+		{name: "runs out of retries", retries: 1, failures: 3, wantErr: true, attempts: 2},
+		{name: "no retries", retries: 0, failures: 1, wantErr: true, attempts: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attempts := 0
+			checkpoints := 0
+			workflow := Workflow{
+				Steps: []WorkflowStep{{
+					Name: "flaky",
+					Run: func(state *WorkflowState) error {
+						attempts++
+						if attempts <= tt.failures {
+							return errors.New("unavailable")
+						}
+						return nil
+					},
+					Retries: tt.retries,
+					Backoff: time.Millisecond,
+				}},
+				OnCheckpoint: func(WorkflowState) error {
+					checkpoints++
+					return nil
+				},
+			}
+
+			var state WorkflowState
+			err := workflow.Run(&state)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Run() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if attempts != tt.attempts {
+				t.Errorf("ran %d attempts, want %d", attempts, tt.attempts)
+			}
+			if done := state.Done("flaky"); done == tt.wantErr || (checkpoints == 1) == tt.wantErr {
+				t.Errorf("Done() = %v with %d checkpoints after error %v", done, checkpoints, err)
+			}
+		})
+	}
+}